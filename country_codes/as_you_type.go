@@ -0,0 +1,244 @@
+package countrycodes
+
+import (
+	"strings"
+)
+
+// asYouTypePadding digits completing a partial national number when looking for a matching NumberFormat
+var asYouTypePadding = []string{"5", "0", "9", "1"}
+
+// AsYouTypeFormatter data structure for formatting a phone number while it is typed digit by digit,
+// it is not safe for concurrent use
+type AsYouTypeFormatter struct {
+	defaultCountry ISO3166
+	country        ISO3166
+	digits         string
+	plusSign       bool
+	// internationalPrefix typed in place of the + sign, e.g. 00
+	internationalPrefix string
+	callingCode         string
+	nationalPrefix      string
+	nationalNumber      string
+}
+
+// NewAsYouTypeFormatter function for creating as you type formatter of the country with alpha2,
+// an unknown alpha2 uses the default country of ParsePhone
+func NewAsYouTypeFormatter(alpha2 string) *AsYouTypeFormatter {
+	country, ok := getRegistry().byCountry(alpha2)
+	if !ok || len(alpha2) != 2 {
+		country, _ = getRegistry().byCountry("")
+	}
+	return &AsYouTypeFormatter{defaultCountry: country, country: country}
+}
+
+// Country method for getting the country of the typed number, switched by a + sign or international prefix,
+// empty while the typed calling code is unknown. The country is a copy, changing it does not affect the registry
+func (f *AsYouTypeFormatter) Country() ISO3166 {
+	return f.country.clone()
+}
+
+// Clear method for removing every typed character
+func (f *AsYouTypeFormatter) Clear() {
+	*f = AsYouTypeFormatter{defaultCountry: f.defaultCountry, country: f.defaultCountry}
+}
+
+// InputDigit method for typing c and getting the formatted number, a + is only accepted as first character
+// and any other non digit character is ignored
+func (f *AsYouTypeFormatter) InputDigit(c rune) string {
+	switch {
+	case c == '+' && f.digits == "" && !f.plusSign:
+		f.plusSign = true
+	case c >= '0' && c <= '9':
+		f.digits += string(c)
+		f.split()
+	}
+	return f.format()
+}
+
+// Input method for typing every character of s and getting the formatted number
+func (f *AsYouTypeFormatter) Input(s string) string {
+	formatted := f.format()
+	for _, c := range s {
+		formatted = f.InputDigit(c)
+	}
+	return formatted
+}
+
+// split method for splitting the typed digits into international prefix, calling code, national prefix
+// and national number and switching the country
+func (f *AsYouTypeFormatter) split() {
+	digits := f.digits
+	f.internationalPrefix, f.callingCode, f.nationalPrefix = "", "", ""
+
+	if !f.plusSign {
+		prefix := typedInternationalPrefix(digits, f.defaultCountry)
+		if prefix == "" {
+			f.country = f.defaultCountry
+			if f.country.NationalPrefix != "" && strings.HasPrefix(digits, f.country.NationalPrefix) {
+				f.nationalPrefix = f.country.NationalPrefix
+				digits = digits[len(f.nationalPrefix):]
+			}
+			f.nationalNumber = digits
+			return
+		}
+		f.internationalPrefix = prefix
+		digits = digits[len(prefix):]
+	}
+
+	reg := getRegistry()
+	candidates := reg.candidates(digits)
+	if len(candidates) == 0 {
+		f.country = ISO3166{}
+		f.nationalNumber = digits
+		return
+	}
+	f.callingCode = reg.countries[candidates[0]].CountryCode
+	f.nationalNumber = digits[len(f.callingCode):]
+	f.country = typedCountry(f.callingCode, f.nationalNumber, candidates)
+}
+
+// format method for getting the typed number formatted so far
+func (f *AsYouTypeFormatter) format() string {
+	if !f.plusSign && f.internationalPrefix == "" {
+		return f.groupPartial(false)
+	}
+
+	prefix := "+"
+	if f.internationalPrefix != "" {
+		prefix = f.internationalPrefix + " "
+	}
+	switch {
+	case f.callingCode == "":
+		return prefix + f.nationalNumber
+	case f.nationalNumber == "":
+		return prefix + f.callingCode
+	}
+	return prefix + f.callingCode + " " + f.groupPartial(true)
+}
+
+// groupPartial method for grouping the typed national number with the first NumberFormat matching
+// a completion of it, cut after the last typed digit
+func (f *AsYouTypeFormatter) groupPartial(international bool) string {
+	number := f.nationalNumber
+	if number == "" {
+		return f.nationalPrefix
+	}
+
+	reg := getRegistry()
+	for _, length := range descendingLengths(f.country.PhoneNumberLengths, len(number)) {
+		for _, nf := range f.country.NumberFormats {
+			if nf.LeadingDigits != "" {
+				leading := reg.pattern(leadingDigitsPattern(nf.LeadingDigits))
+				if leading == nil || !leading.MatchString(number) {
+					continue
+				}
+			}
+			r := reg.pattern(nf.Pattern)
+			if r == nil {
+				continue
+			}
+
+			for _, pad := range asYouTypePadding {
+				complete := number + strings.Repeat(pad, length-len(number))
+				if !r.MatchString(complete) {
+					continue
+				}
+
+				template := formatTemplate(nf, international, f.nationalPrefix)
+				digits := len(number)
+				prefix := ""
+				if !international && f.nationalPrefix != "" {
+					if strings.Contains(nf.Format, nationalPrefixPlaceholder) {
+						digits += len(f.nationalPrefix)
+					} else {
+						// the typed national prefix is kept in front when the format drops it
+						prefix = f.nationalPrefix + " "
+					}
+				}
+				grouped := strings.TrimSpace(r.ReplaceAllString(complete, template))
+				return prefix + cutAfterDigits(grouped, digits)
+			}
+		}
+	}
+	if international {
+		return number
+	}
+	return f.nationalPrefix + number
+}
+
+// typedInternationalPrefix function for getting the longest international prefix of country followed by
+// more typed digits, or ownCallingCodePrefix followed by the calling code of country,
+// empty while digits may still be a national number
+func typedInternationalPrefix(digits string, country ISO3166) string {
+	prefix := ""
+	for _, p := range country.InternationalPrefixes {
+		if len(digits) > len(p) && len(p) > len(prefix) && strings.HasPrefix(digits, p) {
+			prefix = p
+		}
+	}
+	if prefix == "" && strings.HasPrefix(digits, ownCallingCodePrefix+country.CountryCode) {
+		prefix = ownCallingCodePrefix
+	}
+	return prefix
+}
+
+// typedCountry function for getting the country of calling code owning the longest typed prefix,
+// the main country of calling code while the typed digits do not tell countries apart
+func typedCountry(callingCode, nationalNumber string, candidates []int) ISO3166 {
+	reg := getRegistry()
+	best, bestLength := -1, -1
+	for _, k := range candidates {
+		i := reg.countries[k]
+		if i.CountryCode != callingCode {
+			continue
+		}
+		length := 0
+		for _, t := range numberTypes {
+			if l := longestPrefix(nationalNumber, i.BeginWith(t)); l > length {
+				length = l
+			}
+		}
+		if length > bestLength || length == bestLength && i.MainCountryForCode {
+			best, bestLength = k, length
+		}
+	}
+	return reg.countries[best].clone()
+}
+
+// descendingLengths function for getting lengths not shorter than minimum, longest first
+func descendingLengths(lengths []int, minimum int) []int {
+	var descending []int
+	for _, l := range lengths {
+		if l >= minimum {
+			descending = append(descending, l)
+		}
+	}
+	for a := 1; a < len(descending); a++ {
+		for b := a; b > 0 && descending[b] > descending[b-1]; b-- {
+			descending[b], descending[b-1] = descending[b-1], descending[b]
+		}
+	}
+	return descending
+}
+
+// cutAfterDigits function for cutting formatted after its n-th digit,
+// an opening parenthesis is dropped until its group is complete
+func cutAfterDigits(formatted string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	count := 0
+	for k, c := range formatted {
+		if c >= '0' && c <= '9' {
+			count++
+			if count == n {
+				formatted = formatted[:k+1]
+				break
+			}
+		}
+	}
+	if strings.Count(formatted, "(") > strings.Count(formatted, ")") {
+		formatted = strings.Replace(formatted, "(", "", 1)
+	}
+	return formatted
+}
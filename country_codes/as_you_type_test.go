@@ -0,0 +1,86 @@
+package countrycodes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsYouTypeFormatter(t *testing.T) {
+	testCases := []struct {
+		name, alpha2, input string
+		expected            []string
+		country             string
+	}{
+		{name: "Testcase #1: national with national prefix", alpha2: "ID", input: "081234567890",
+			expected: []string{"0", "08", "081", "0812", "0812-3", "0812-34", "0812-345", "0812-3456",
+				"0812-3456-7", "0812-3456-78", "0812-3456-789", "0812-3456-7890"}, country: "ID"},
+		{name: "Testcase #2: international with plus sign", alpha2: "US", input: "+6281234567890",
+			expected: []string{"+", "+6", "+62", "+62 8", "+62 81", "+62 812", "+62 812-3", "+62 812-34",
+				"+62 812-345", "+62 812-3456", "+62 812-3456-7", "+62 812-3456-78", "+62 812-3456-789",
+				"+62 812-3456-7890"}, country: "ID"},
+		{name: "Testcase #3: NANP national", alpha2: "US", input: "2015550123",
+			expected: []string{"2", "20", "201", "(201) 5", "(201) 55", "(201) 555", "(201) 555-0",
+				"(201) 555-01", "(201) 555-012", "(201) 555-0123"}, country: "US"},
+		{name: "Testcase #4: international prefix switches country", alpha2: "MY", input: "00447911123456",
+			expected: []string{"0", "00", "00 4", "00 44", "00 44 7", "00 44 79", "00 44 791", "00 44 7911",
+				"00 44 7911 1", "00 44 7911 12", "00 44 7911 123", "00 44 7911 1234", "00 44 7911 12345",
+				"00 44 7911 123456"}, country: "GB"},
+		{name: "Testcase #5: shared calling code resolved by leading digits", alpha2: "GB", input: "+12645",
+			expected: []string{"+", "+1", "+1 2", "+1 26", "+1 264", "+1 264-5"}, country: "AI"},
+		{name: "Testcase #6: unknown calling code", alpha2: "GB", input: "+999",
+			expected: []string{"+", "+9", "+99", "+999"}, country: ""},
+		{name: "Testcase #7: non digit ignored", alpha2: "SG", input: "9123-4567",
+			expected: []string{"9", "91", "912", "9123", "9123", "9123 4", "9123 45", "9123 456", "9123 4567"}, country: "SG"},
+		{name: "Testcase #8: international prefix of the default country", alpha2: "ID", input: "00112015550123",
+			expected: []string{"0", "00", "001", "001 1", "001 1 2", "001 1 20", "001 1 201", "001 1 201-5",
+				"001 1 201-55", "001 1 201-555", "001 1 201-555-0", "001 1 201-555-01", "001 1 201-555-012",
+				"001 1 201-555-0123"}, country: "US"},
+		{name: "Testcase #9: 00 and own calling code", alpha2: "ID", input: "006281234567890",
+			expected: []string{"0", "00", "006", "00 62", "00 62 8", "00 62 81", "00 62 812", "00 62 812-3",
+				"00 62 812-34", "00 62 812-345", "00 62 812-3456", "00 62 812-3456-7", "00 62 812-3456-78",
+				"00 62 812-3456-789", "00 62 812-3456-7890"}, country: "ID"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewAsYouTypeFormatter(tc.alpha2)
+			var got []string
+			for _, c := range tc.input {
+				got = append(got, f.InputDigit(c))
+			}
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, tc.country, f.Country().Alpha2)
+		})
+	}
+}
+
+func TestAsYouTypeFormatterClear(t *testing.T) {
+	f := NewAsYouTypeFormatter("xx")
+	assert.Equal(t, "US", f.Country().Alpha2)
+
+	assert.Equal(t, "+44 7911 123456", f.Input("+447911123456"))
+	assert.Equal(t, "GB", f.Country().Alpha2)
+
+	f.Clear()
+	assert.Equal(t, "US", f.Country().Alpha2)
+	assert.Equal(t, "(201) 555-0123", f.Input("(201) 555-0123"))
+	assert.Equal(t, "1 (201) 555-0123", NewAsYouTypeFormatter("US").Input("12015550123"))
+}
+
+func TestAsYouTypeFormatterCountryCopy(t *testing.T) {
+	f := NewAsYouTypeFormatter("US")
+	f.Country().PhoneNumberLengths[0] = 0
+
+	assert.Equal(t, "+44 7911 123456", f.Input("+447911123456"))
+	gb := f.Country()
+	for k := range gb.NumberFormats {
+		gb.NumberFormats[k].Format = "$1"
+	}
+	gb.MobileBeginWith[0] = "0"
+
+	f.Clear()
+	assert.Equal(t, "(201) 555-0123", f.Input("2015550123"))
+	assert.Equal(t, "+44 7911 123456", NewAsYouTypeFormatter("US").Input("+447911123456"))
+	assert.Equal(t, "447911123456", Parse("+447911123456", ""))
+}
@@ -0,0 +1,257 @@
+package main
+
+import countrycodes "github.com/willy182/goshare/country_codes"
+
+// countryDatas ISO 3166-1 countries sorted by alpha2, names are taken from the iso-codes project,
+// region and sub-region from the UN M49 geoscheme and currencies from ISO 4217
+var countryDatas = []countrycodes.Country{
+	{Alpha2: "AD", Alpha3: "AND", Numeric: "020", Name: "Andorra", OfficialName: "Principality of Andorra", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"EUR"}, TLD: ".ad", Capital: "Andorra la Vella"},
+	{Alpha2: "AE", Alpha3: "ARE", Numeric: "784", Name: "United Arab Emirates", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"AED"}, TLD: ".ae", Capital: "Abu Dhabi"},
+	{Alpha2: "AF", Alpha3: "AFG", Numeric: "004", Name: "Afghanistan", OfficialName: "Islamic Republic of Afghanistan", Region: "Asia", SubRegion: "Southern Asia", Currencies: []string{"AFN"}, TLD: ".af", Capital: "Kabul"},
+	{Alpha2: "AG", Alpha3: "ATG", Numeric: "028", Name: "Antigua and Barbuda", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"XCD"}, TLD: ".ag", Capital: "Saint John's"},
+	{Alpha2: "AI", Alpha3: "AIA", Numeric: "660", Name: "Anguilla", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"XCD"}, TLD: ".ai", Capital: "The Valley"},
+	{Alpha2: "AL", Alpha3: "ALB", Numeric: "008", Name: "Albania", OfficialName: "Republic of Albania", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"ALL"}, TLD: ".al", Capital: "Tirana"},
+	{Alpha2: "AM", Alpha3: "ARM", Numeric: "051", Name: "Armenia", OfficialName: "Republic of Armenia", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"AMD"}, TLD: ".am", Capital: "Yerevan"},
+	{Alpha2: "AO", Alpha3: "AGO", Numeric: "024", Name: "Angola", OfficialName: "Republic of Angola", Region: "Africa", SubRegion: "Middle Africa", Currencies: []string{"AOA"}, TLD: ".ao", Capital: "Luanda"},
+	{Alpha2: "AQ", Alpha3: "ATA", Numeric: "010", Name: "Antarctica", TLD: ".aq"},
+	{Alpha2: "AR", Alpha3: "ARG", Numeric: "032", Name: "Argentina", OfficialName: "Argentine Republic", Region: "Americas", SubRegion: "South America", Currencies: []string{"ARS"}, TLD: ".ar", Capital: "Buenos Aires"},
+	{Alpha2: "AS", Alpha3: "ASM", Numeric: "016", Name: "American Samoa", Region: "Oceania", SubRegion: "Polynesia", Currencies: []string{"USD"}, TLD: ".as", Capital: "Pago Pago"},
+	{Alpha2: "AT", Alpha3: "AUT", Numeric: "040", Name: "Austria", OfficialName: "Republic of Austria", Region: "Europe", SubRegion: "Western Europe", Currencies: []string{"EUR"}, TLD: ".at", Capital: "Vienna"},
+	{Alpha2: "AU", Alpha3: "AUS", Numeric: "036", Name: "Australia", Region: "Oceania", SubRegion: "Australia and New Zealand", Currencies: []string{"AUD"}, TLD: ".au", Capital: "Canberra"},
+	{Alpha2: "AW", Alpha3: "ABW", Numeric: "533", Name: "Aruba", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"AWG"}, TLD: ".aw", Capital: "Oranjestad"},
+	{Alpha2: "AX", Alpha3: "ALA", Numeric: "248", Name: "Åland Islands", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"EUR"}, TLD: ".ax", Capital: "Mariehamn"},
+	{Alpha2: "AZ", Alpha3: "AZE", Numeric: "031", Name: "Azerbaijan", OfficialName: "Republic of Azerbaijan", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"AZN"}, TLD: ".az", Capital: "Baku"},
+	{Alpha2: "BA", Alpha3: "BIH", Numeric: "070", Name: "Bosnia and Herzegovina", OfficialName: "Republic of Bosnia and Herzegovina", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"BAM"}, TLD: ".ba", Capital: "Sarajevo"},
+	{Alpha2: "BB", Alpha3: "BRB", Numeric: "052", Name: "Barbados", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"BBD"}, TLD: ".bb", Capital: "Bridgetown"},
+	{Alpha2: "BD", Alpha3: "BGD", Numeric: "050", Name: "Bangladesh", OfficialName: "People's Republic of Bangladesh", Region: "Asia", SubRegion: "Southern Asia", Currencies: []string{"BDT"}, TLD: ".bd", Capital: "Dhaka"},
+	{Alpha2: "BE", Alpha3: "BEL", Numeric: "056", Name: "Belgium", OfficialName: "Kingdom of Belgium", Region: "Europe", SubRegion: "Western Europe", Currencies: []string{"EUR"}, TLD: ".be", Capital: "Brussels"},
+	{Alpha2: "BF", Alpha3: "BFA", Numeric: "854", Name: "Burkina Faso", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"XOF"}, TLD: ".bf", Capital: "Ouagadougou"},
+	{Alpha2: "BG", Alpha3: "BGR", Numeric: "100", Name: "Bulgaria", OfficialName: "Republic of Bulgaria", Region: "Europe", SubRegion: "Eastern Europe", Currencies: []string{"EUR"}, TLD: ".bg", Capital: "Sofia"},
+	{Alpha2: "BH", Alpha3: "BHR", Numeric: "048", Name: "Bahrain", OfficialName: "Kingdom of Bahrain", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"BHD"}, TLD: ".bh", Capital: "Manama"},
+	{Alpha2: "BI", Alpha3: "BDI", Numeric: "108", Name: "Burundi", OfficialName: "Republic of Burundi", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"BIF"}, TLD: ".bi", Capital: "Gitega"},
+	{Alpha2: "BJ", Alpha3: "BEN", Numeric: "204", Name: "Benin", OfficialName: "Republic of Benin", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"XOF"}, TLD: ".bj", Capital: "Porto-Novo"},
+	{Alpha2: "BL", Alpha3: "BLM", Numeric: "652", Name: "Saint Barthélemy", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"EUR"}, Capital: "Gustavia"},
+	{Alpha2: "BM", Alpha3: "BMU", Numeric: "060", Name: "Bermuda", Region: "Americas", SubRegion: "Northern America", Currencies: []string{"BMD"}, TLD: ".bm", Capital: "Hamilton"},
+	{Alpha2: "BN", Alpha3: "BRN", Numeric: "096", Name: "Brunei Darussalam", Region: "Asia", SubRegion: "South-eastern Asia", Currencies: []string{"BND"}, TLD: ".bn", Capital: "Bandar Seri Begawan"},
+	{Alpha2: "BO", Alpha3: "BOL", Numeric: "068", Name: "Bolivia, Plurinational State of", OfficialName: "Plurinational State of Bolivia", CommonName: "Bolivia", Region: "Americas", SubRegion: "South America", Currencies: []string{"BOB"}, TLD: ".bo", Capital: "Sucre"},
+	{Alpha2: "BQ", Alpha3: "BES", Numeric: "535", Name: "Bonaire, Sint Eustatius and Saba", OfficialName: "Bonaire, Sint Eustatius and Saba", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"USD"}, TLD: ".bq", Capital: "Kralendijk"},
+	{Alpha2: "BR", Alpha3: "BRA", Numeric: "076", Name: "Brazil", OfficialName: "Federative Republic of Brazil", Region: "Americas", SubRegion: "South America", Currencies: []string{"BRL"}, TLD: ".br", Capital: "Brasília"},
+	{Alpha2: "BS", Alpha3: "BHS", Numeric: "044", Name: "Bahamas", OfficialName: "Commonwealth of the Bahamas", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"BSD"}, TLD: ".bs", Capital: "Nassau"},
+	{Alpha2: "BT", Alpha3: "BTN", Numeric: "064", Name: "Bhutan", OfficialName: "Kingdom of Bhutan", Region: "Asia", SubRegion: "Southern Asia", Currencies: []string{"BTN", "INR"}, TLD: ".bt", Capital: "Thimphu"},
+	{Alpha2: "BV", Alpha3: "BVT", Numeric: "074", Name: "Bouvet Island", Region: "Americas", SubRegion: "South America", Currencies: []string{"NOK"}, TLD: ".bv"},
+	{Alpha2: "BW", Alpha3: "BWA", Numeric: "072", Name: "Botswana", OfficialName: "Republic of Botswana", Region: "Africa", SubRegion: "Southern Africa", Currencies: []string{"BWP"}, TLD: ".bw", Capital: "Gaborone"},
+	{Alpha2: "BY", Alpha3: "BLR", Numeric: "112", Name: "Belarus", OfficialName: "Republic of Belarus", Region: "Europe", SubRegion: "Eastern Europe", Currencies: []string{"BYN"}, TLD: ".by", Capital: "Minsk"},
+	{Alpha2: "BZ", Alpha3: "BLZ", Numeric: "084", Name: "Belize", Region: "Americas", SubRegion: "Central America", Currencies: []string{"BZD"}, TLD: ".bz", Capital: "Belmopan"},
+	{Alpha2: "CA", Alpha3: "CAN", Numeric: "124", Name: "Canada", Region: "Americas", SubRegion: "Northern America", Currencies: []string{"CAD"}, TLD: ".ca", Capital: "Ottawa"},
+	{Alpha2: "CC", Alpha3: "CCK", Numeric: "166", Name: "Cocos (Keeling) Islands", Region: "Oceania", SubRegion: "Australia and New Zealand", Currencies: []string{"AUD"}, TLD: ".cc", Capital: "West Island"},
+	{Alpha2: "CD", Alpha3: "COD", Numeric: "180", Name: "Congo, The Democratic Republic of the", Region: "Africa", SubRegion: "Middle Africa", Currencies: []string{"CDF"}, TLD: ".cd", Capital: "Kinshasa"},
+	{Alpha2: "CF", Alpha3: "CAF", Numeric: "140", Name: "Central African Republic", Region: "Africa", SubRegion: "Middle Africa", Currencies: []string{"XAF"}, TLD: ".cf", Capital: "Bangui"},
+	{Alpha2: "CG", Alpha3: "COG", Numeric: "178", Name: "Congo", OfficialName: "Republic of the Congo", Region: "Africa", SubRegion: "Middle Africa", Currencies: []string{"XAF"}, TLD: ".cg", Capital: "Brazzaville"},
+	{Alpha2: "CH", Alpha3: "CHE", Numeric: "756", Name: "Switzerland", OfficialName: "Swiss Confederation", Region: "Europe", SubRegion: "Western Europe", Currencies: []string{"CHF"}, TLD: ".ch", Capital: "Bern"},
+	{Alpha2: "CI", Alpha3: "CIV", Numeric: "384", Name: "Côte d'Ivoire", OfficialName: "Republic of Côte d'Ivoire", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"XOF"}, TLD: ".ci", Capital: "Yamoussoukro"},
+	{Alpha2: "CK", Alpha3: "COK", Numeric: "184", Name: "Cook Islands", Region: "Oceania", SubRegion: "Polynesia", Currencies: []string{"NZD"}, TLD: ".ck", Capital: "Avarua"},
+	{Alpha2: "CL", Alpha3: "CHL", Numeric: "152", Name: "Chile", OfficialName: "Republic of Chile", Region: "Americas", SubRegion: "South America", Currencies: []string{"CLP"}, TLD: ".cl", Capital: "Santiago"},
+	{Alpha2: "CM", Alpha3: "CMR", Numeric: "120", Name: "Cameroon", OfficialName: "Republic of Cameroon", Region: "Africa", SubRegion: "Middle Africa", Currencies: []string{"XAF"}, TLD: ".cm", Capital: "Yaoundé"},
+	{Alpha2: "CN", Alpha3: "CHN", Numeric: "156", Name: "China", OfficialName: "People's Republic of China", Region: "Asia", SubRegion: "Eastern Asia", Currencies: []string{"CNY"}, TLD: ".cn", Capital: "Beijing"},
+	{Alpha2: "CO", Alpha3: "COL", Numeric: "170", Name: "Colombia", OfficialName: "Republic of Colombia", Region: "Americas", SubRegion: "South America", Currencies: []string{"COP"}, TLD: ".co", Capital: "Bogotá"},
+	{Alpha2: "CR", Alpha3: "CRI", Numeric: "188", Name: "Costa Rica", OfficialName: "Republic of Costa Rica", Region: "Americas", SubRegion: "Central America", Currencies: []string{"CRC"}, TLD: ".cr", Capital: "San José"},
+	{Alpha2: "CU", Alpha3: "CUB", Numeric: "192", Name: "Cuba", OfficialName: "Republic of Cuba", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"CUP"}, TLD: ".cu", Capital: "Havana"},
+	{Alpha2: "CV", Alpha3: "CPV", Numeric: "132", Name: "Cabo Verde", OfficialName: "Republic of Cabo Verde", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"CVE"}, TLD: ".cv", Capital: "Praia"},
+	{Alpha2: "CW", Alpha3: "CUW", Numeric: "531", Name: "Curaçao", OfficialName: "Curaçao", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"XCG"}, TLD: ".cw", Capital: "Willemstad"},
+	{Alpha2: "CX", Alpha3: "CXR", Numeric: "162", Name: "Christmas Island", Region: "Oceania", SubRegion: "Australia and New Zealand", Currencies: []string{"AUD"}, TLD: ".cx", Capital: "Flying Fish Cove"},
+	{Alpha2: "CY", Alpha3: "CYP", Numeric: "196", Name: "Cyprus", OfficialName: "Republic of Cyprus", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"EUR"}, TLD: ".cy", Capital: "Nicosia"},
+	{Alpha2: "CZ", Alpha3: "CZE", Numeric: "203", Name: "Czechia", OfficialName: "Czech Republic", Region: "Europe", SubRegion: "Eastern Europe", Currencies: []string{"CZK"}, TLD: ".cz", Capital: "Prague"},
+	{Alpha2: "DE", Alpha3: "DEU", Numeric: "276", Name: "Germany", OfficialName: "Federal Republic of Germany", Region: "Europe", SubRegion: "Western Europe", Currencies: []string{"EUR"}, TLD: ".de", Capital: "Berlin"},
+	{Alpha2: "DJ", Alpha3: "DJI", Numeric: "262", Name: "Djibouti", OfficialName: "Republic of Djibouti", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"DJF"}, TLD: ".dj", Capital: "Djibouti"},
+	{Alpha2: "DK", Alpha3: "DNK", Numeric: "208", Name: "Denmark", OfficialName: "Kingdom of Denmark", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"DKK"}, TLD: ".dk", Capital: "Copenhagen"},
+	{Alpha2: "DM", Alpha3: "DMA", Numeric: "212", Name: "Dominica", OfficialName: "Commonwealth of Dominica", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"XCD"}, TLD: ".dm", Capital: "Roseau"},
+	{Alpha2: "DO", Alpha3: "DOM", Numeric: "214", Name: "Dominican Republic", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"DOP"}, TLD: ".do", Capital: "Santo Domingo"},
+	{Alpha2: "DZ", Alpha3: "DZA", Numeric: "012", Name: "Algeria", OfficialName: "People's Democratic Republic of Algeria", Region: "Africa", SubRegion: "Northern Africa", Currencies: []string{"DZD"}, TLD: ".dz", Capital: "Algiers"},
+	{Alpha2: "EC", Alpha3: "ECU", Numeric: "218", Name: "Ecuador", OfficialName: "Republic of Ecuador", Region: "Americas", SubRegion: "South America", Currencies: []string{"USD"}, TLD: ".ec", Capital: "Quito"},
+	{Alpha2: "EE", Alpha3: "EST", Numeric: "233", Name: "Estonia", OfficialName: "Republic of Estonia", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"EUR"}, TLD: ".ee", Capital: "Tallinn"},
+	{Alpha2: "EG", Alpha3: "EGY", Numeric: "818", Name: "Egypt", OfficialName: "Arab Republic of Egypt", Region: "Africa", SubRegion: "Northern Africa", Currencies: []string{"EGP"}, TLD: ".eg", Capital: "Cairo"},
+	{Alpha2: "EH", Alpha3: "ESH", Numeric: "732", Name: "Western Sahara", Region: "Africa", SubRegion: "Northern Africa", Currencies: []string{"MAD"}, Capital: "Laayoune"},
+	{Alpha2: "ER", Alpha3: "ERI", Numeric: "232", Name: "Eritrea", OfficialName: "the State of Eritrea", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"ERN"}, TLD: ".er", Capital: "Asmara"},
+	{Alpha2: "ES", Alpha3: "ESP", Numeric: "724", Name: "Spain", OfficialName: "Kingdom of Spain", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"EUR"}, TLD: ".es", Capital: "Madrid"},
+	{Alpha2: "ET", Alpha3: "ETH", Numeric: "231", Name: "Ethiopia", OfficialName: "Federal Democratic Republic of Ethiopia", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"ETB"}, TLD: ".et", Capital: "Addis Ababa"},
+	{Alpha2: "FI", Alpha3: "FIN", Numeric: "246", Name: "Finland", OfficialName: "Republic of Finland", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"EUR"}, TLD: ".fi", Capital: "Helsinki"},
+	{Alpha2: "FJ", Alpha3: "FJI", Numeric: "242", Name: "Fiji", OfficialName: "Republic of Fiji", Region: "Oceania", SubRegion: "Melanesia", Currencies: []string{"FJD"}, TLD: ".fj", Capital: "Suva"},
+	{Alpha2: "FK", Alpha3: "FLK", Numeric: "238", Name: "Falkland Islands (Malvinas)", Region: "Americas", SubRegion: "South America", Currencies: []string{"FKP"}, TLD: ".fk", Capital: "Stanley"},
+	{Alpha2: "FM", Alpha3: "FSM", Numeric: "583", Name: "Micronesia, Federated States of", OfficialName: "Federated States of Micronesia", Region: "Oceania", SubRegion: "Micronesia", Currencies: []string{"USD"}, TLD: ".fm", Capital: "Palikir"},
+	{Alpha2: "FO", Alpha3: "FRO", Numeric: "234", Name: "Faroe Islands", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"DKK"}, TLD: ".fo", Capital: "Tórshavn"},
+	{Alpha2: "FR", Alpha3: "FRA", Numeric: "250", Name: "France", OfficialName: "French Republic", Region: "Europe", SubRegion: "Western Europe", Currencies: []string{"EUR"}, TLD: ".fr", Capital: "Paris"},
+	{Alpha2: "GA", Alpha3: "GAB", Numeric: "266", Name: "Gabon", OfficialName: "Gabonese Republic", Region: "Africa", SubRegion: "Middle Africa", Currencies: []string{"XAF"}, TLD: ".ga", Capital: "Libreville"},
+	{Alpha2: "GB", Alpha3: "GBR", Numeric: "826", Name: "United Kingdom", OfficialName: "United Kingdom of Great Britain and Northern Ireland", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"GBP"}, TLD: ".uk", Capital: "London"},
+	{Alpha2: "GD", Alpha3: "GRD", Numeric: "308", Name: "Grenada", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"XCD"}, TLD: ".gd", Capital: "St. George's"},
+	{Alpha2: "GE", Alpha3: "GEO", Numeric: "268", Name: "Georgia", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"GEL"}, TLD: ".ge", Capital: "Tbilisi"},
+	{Alpha2: "GF", Alpha3: "GUF", Numeric: "254", Name: "French Guiana", Region: "Americas", SubRegion: "South America", Currencies: []string{"EUR"}, TLD: ".gf", Capital: "Cayenne"},
+	{Alpha2: "GG", Alpha3: "GGY", Numeric: "831", Name: "Guernsey", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"GBP"}, TLD: ".gg", Capital: "St Peter Port"},
+	{Alpha2: "GH", Alpha3: "GHA", Numeric: "288", Name: "Ghana", OfficialName: "Republic of Ghana", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"GHS"}, TLD: ".gh", Capital: "Accra"},
+	{Alpha2: "GI", Alpha3: "GIB", Numeric: "292", Name: "Gibraltar", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"GIP"}, TLD: ".gi", Capital: "Gibraltar"},
+	{Alpha2: "GL", Alpha3: "GRL", Numeric: "304", Name: "Greenland", Region: "Americas", SubRegion: "Northern America", Currencies: []string{"DKK"}, TLD: ".gl", Capital: "Nuuk"},
+	{Alpha2: "GM", Alpha3: "GMB", Numeric: "270", Name: "Gambia", OfficialName: "Republic of the Gambia", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"GMD"}, TLD: ".gm", Capital: "Banjul"},
+	{Alpha2: "GN", Alpha3: "GIN", Numeric: "324", Name: "Guinea", OfficialName: "Republic of Guinea", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"GNF"}, TLD: ".gn", Capital: "Conakry"},
+	{Alpha2: "GP", Alpha3: "GLP", Numeric: "312", Name: "Guadeloupe", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"EUR"}, TLD: ".gp", Capital: "Basse-Terre"},
+	{Alpha2: "GQ", Alpha3: "GNQ", Numeric: "226", Name: "Equatorial Guinea", OfficialName: "Republic of Equatorial Guinea", Region: "Africa", SubRegion: "Middle Africa", Currencies: []string{"XAF"}, TLD: ".gq", Capital: "Malabo"},
+	{Alpha2: "GR", Alpha3: "GRC", Numeric: "300", Name: "Greece", OfficialName: "Hellenic Republic", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"EUR"}, TLD: ".gr", Capital: "Athens"},
+	{Alpha2: "GS", Alpha3: "SGS", Numeric: "239", Name: "South Georgia and the South Sandwich Islands", Region: "Americas", SubRegion: "South America", Currencies: []string{"GBP"}, TLD: ".gs", Capital: "King Edward Point"},
+	{Alpha2: "GT", Alpha3: "GTM", Numeric: "320", Name: "Guatemala", OfficialName: "Republic of Guatemala", Region: "Americas", SubRegion: "Central America", Currencies: []string{"GTQ"}, TLD: ".gt", Capital: "Guatemala City"},
+	{Alpha2: "GU", Alpha3: "GUM", Numeric: "316", Name: "Guam", Region: "Oceania", SubRegion: "Micronesia", Currencies: []string{"USD"}, TLD: ".gu", Capital: "Hagåtña"},
+	{Alpha2: "GW", Alpha3: "GNB", Numeric: "624", Name: "Guinea-Bissau", OfficialName: "Republic of Guinea-Bissau", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"XOF"}, TLD: ".gw", Capital: "Bissau"},
+	{Alpha2: "GY", Alpha3: "GUY", Numeric: "328", Name: "Guyana", OfficialName: "Republic of Guyana", Region: "Americas", SubRegion: "South America", Currencies: []string{"GYD"}, TLD: ".gy", Capital: "Georgetown"},
+	{Alpha2: "HK", Alpha3: "HKG", Numeric: "344", Name: "Hong Kong", OfficialName: "Hong Kong Special Administrative Region of China", Region: "Asia", SubRegion: "Eastern Asia", Currencies: []string{"HKD"}, TLD: ".hk"},
+	{Alpha2: "HM", Alpha3: "HMD", Numeric: "334", Name: "Heard Island and McDonald Islands", Region: "Oceania", SubRegion: "Australia and New Zealand", Currencies: []string{"AUD"}, TLD: ".hm"},
+	{Alpha2: "HN", Alpha3: "HND", Numeric: "340", Name: "Honduras", OfficialName: "Republic of Honduras", Region: "Americas", SubRegion: "Central America", Currencies: []string{"HNL"}, TLD: ".hn", Capital: "Tegucigalpa"},
+	{Alpha2: "HR", Alpha3: "HRV", Numeric: "191", Name: "Croatia", OfficialName: "Republic of Croatia", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"EUR"}, TLD: ".hr", Capital: "Zagreb"},
+	{Alpha2: "HT", Alpha3: "HTI", Numeric: "332", Name: "Haiti", OfficialName: "Republic of Haiti", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"HTG"}, TLD: ".ht", Capital: "Port-au-Prince"},
+	{Alpha2: "HU", Alpha3: "HUN", Numeric: "348", Name: "Hungary", OfficialName: "Hungary", Region: "Europe", SubRegion: "Eastern Europe", Currencies: []string{"HUF"}, TLD: ".hu", Capital: "Budapest"},
+	{Alpha2: "ID", Alpha3: "IDN", Numeric: "360", Name: "Indonesia", OfficialName: "Republic of Indonesia", Region: "Asia", SubRegion: "South-eastern Asia", Currencies: []string{"IDR"}, TLD: ".id", Capital: "Jakarta"},
+	{Alpha2: "IE", Alpha3: "IRL", Numeric: "372", Name: "Ireland", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"EUR"}, TLD: ".ie", Capital: "Dublin"},
+	{Alpha2: "IL", Alpha3: "ISR", Numeric: "376", Name: "Israel", OfficialName: "State of Israel", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"ILS"}, TLD: ".il", Capital: "Jerusalem"},
+	{Alpha2: "IM", Alpha3: "IMN", Numeric: "833", Name: "Isle of Man", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"GBP"}, TLD: ".im", Capital: "Douglas"},
+	{Alpha2: "IN", Alpha3: "IND", Numeric: "356", Name: "India", OfficialName: "Republic of India", Region: "Asia", SubRegion: "Southern Asia", Currencies: []string{"INR"}, TLD: ".in", Capital: "New Delhi"},
+	{Alpha2: "IO", Alpha3: "IOT", Numeric: "086", Name: "British Indian Ocean Territory", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"USD"}, TLD: ".io", Capital: "Diego Garcia"},
+	{Alpha2: "IQ", Alpha3: "IRQ", Numeric: "368", Name: "Iraq", OfficialName: "Republic of Iraq", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"IQD"}, TLD: ".iq", Capital: "Baghdad"},
+	{Alpha2: "IR", Alpha3: "IRN", Numeric: "364", Name: "Iran, Islamic Republic of", OfficialName: "Islamic Republic of Iran", CommonName: "Iran", Region: "Asia", SubRegion: "Southern Asia", Currencies: []string{"IRR"}, TLD: ".ir", Capital: "Tehran"},
+	{Alpha2: "IS", Alpha3: "ISL", Numeric: "352", Name: "Iceland", OfficialName: "Republic of Iceland", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"ISK"}, TLD: ".is", Capital: "Reykjavík"},
+	{Alpha2: "IT", Alpha3: "ITA", Numeric: "380", Name: "Italy", OfficialName: "Italian Republic", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"EUR"}, TLD: ".it", Capital: "Rome"},
+	{Alpha2: "JE", Alpha3: "JEY", Numeric: "832", Name: "Jersey", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"GBP"}, TLD: ".je", Capital: "Saint Helier"},
+	{Alpha2: "JM", Alpha3: "JAM", Numeric: "388", Name: "Jamaica", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"JMD"}, TLD: ".jm", Capital: "Kingston"},
+	{Alpha2: "JO", Alpha3: "JOR", Numeric: "400", Name: "Jordan", OfficialName: "Hashemite Kingdom of Jordan", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"JOD"}, TLD: ".jo", Capital: "Amman"},
+	{Alpha2: "JP", Alpha3: "JPN", Numeric: "392", Name: "Japan", Region: "Asia", SubRegion: "Eastern Asia", Currencies: []string{"JPY"}, TLD: ".jp", Capital: "Tokyo"},
+	{Alpha2: "KE", Alpha3: "KEN", Numeric: "404", Name: "Kenya", OfficialName: "Republic of Kenya", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"KES"}, TLD: ".ke", Capital: "Nairobi"},
+	{Alpha2: "KG", Alpha3: "KGZ", Numeric: "417", Name: "Kyrgyzstan", OfficialName: "Kyrgyz Republic", Region: "Asia", SubRegion: "Central Asia", Currencies: []string{"KGS"}, TLD: ".kg", Capital: "Bishkek"},
+	{Alpha2: "KH", Alpha3: "KHM", Numeric: "116", Name: "Cambodia", OfficialName: "Kingdom of Cambodia", Region: "Asia", SubRegion: "South-eastern Asia", Currencies: []string{"KHR"}, TLD: ".kh", Capital: "Phnom Penh"},
+	{Alpha2: "KI", Alpha3: "KIR", Numeric: "296", Name: "Kiribati", OfficialName: "Republic of Kiribati", Region: "Oceania", SubRegion: "Micronesia", Currencies: []string{"AUD"}, TLD: ".ki", Capital: "South Tarawa"},
+	{Alpha2: "KM", Alpha3: "COM", Numeric: "174", Name: "Comoros", OfficialName: "Union of the Comoros", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"KMF"}, TLD: ".km", Capital: "Moroni"},
+	{Alpha2: "KN", Alpha3: "KNA", Numeric: "659", Name: "Saint Kitts and Nevis", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"XCD"}, TLD: ".kn", Capital: "Basseterre"},
+	{Alpha2: "KP", Alpha3: "PRK", Numeric: "408", Name: "Korea, Democratic People's Republic of", OfficialName: "Democratic People's Republic of Korea", CommonName: "North Korea", Region: "Asia", SubRegion: "Eastern Asia", Currencies: []string{"KPW"}, TLD: ".kp", Capital: "Pyongyang"},
+	{Alpha2: "KR", Alpha3: "KOR", Numeric: "410", Name: "Korea, Republic of", CommonName: "South Korea", Region: "Asia", SubRegion: "Eastern Asia", Currencies: []string{"KRW"}, TLD: ".kr", Capital: "Seoul"},
+	{Alpha2: "KW", Alpha3: "KWT", Numeric: "414", Name: "Kuwait", OfficialName: "State of Kuwait", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"KWD"}, TLD: ".kw", Capital: "Kuwait City"},
+	{Alpha2: "KY", Alpha3: "CYM", Numeric: "136", Name: "Cayman Islands", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"KYD"}, TLD: ".ky", Capital: "George Town"},
+	{Alpha2: "KZ", Alpha3: "KAZ", Numeric: "398", Name: "Kazakhstan", OfficialName: "Republic of Kazakhstan", Region: "Asia", SubRegion: "Central Asia", Currencies: []string{"KZT"}, TLD: ".kz", Capital: "Astana"},
+	{Alpha2: "LA", Alpha3: "LAO", Numeric: "418", Name: "Lao People's Democratic Republic", CommonName: "Laos", Region: "Asia", SubRegion: "South-eastern Asia", Currencies: []string{"LAK"}, TLD: ".la", Capital: "Vientiane"},
+	{Alpha2: "LB", Alpha3: "LBN", Numeric: "422", Name: "Lebanon", OfficialName: "Lebanese Republic", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"LBP"}, TLD: ".lb", Capital: "Beirut"},
+	{Alpha2: "LC", Alpha3: "LCA", Numeric: "662", Name: "Saint Lucia", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"XCD"}, TLD: ".lc", Capital: "Castries"},
+	{Alpha2: "LI", Alpha3: "LIE", Numeric: "438", Name: "Liechtenstein", OfficialName: "Principality of Liechtenstein", Region: "Europe", SubRegion: "Western Europe", Currencies: []string{"CHF"}, TLD: ".li", Capital: "Vaduz"},
+	{Alpha2: "LK", Alpha3: "LKA", Numeric: "144", Name: "Sri Lanka", OfficialName: "Democratic Socialist Republic of Sri Lanka", Region: "Asia", SubRegion: "Southern Asia", Currencies: []string{"LKR"}, TLD: ".lk", Capital: "Sri Jayawardenepura Kotte"},
+	{Alpha2: "LR", Alpha3: "LBR", Numeric: "430", Name: "Liberia", OfficialName: "Republic of Liberia", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"LRD"}, TLD: ".lr", Capital: "Monrovia"},
+	{Alpha2: "LS", Alpha3: "LSO", Numeric: "426", Name: "Lesotho", OfficialName: "Kingdom of Lesotho", Region: "Africa", SubRegion: "Southern Africa", Currencies: []string{"LSL", "ZAR"}, TLD: ".ls", Capital: "Maseru"},
+	{Alpha2: "LT", Alpha3: "LTU", Numeric: "440", Name: "Lithuania", OfficialName: "Republic of Lithuania", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"EUR"}, TLD: ".lt", Capital: "Vilnius"},
+	{Alpha2: "LU", Alpha3: "LUX", Numeric: "442", Name: "Luxembourg", OfficialName: "Grand Duchy of Luxembourg", Region: "Europe", SubRegion: "Western Europe", Currencies: []string{"EUR"}, TLD: ".lu", Capital: "Luxembourg"},
+	{Alpha2: "LV", Alpha3: "LVA", Numeric: "428", Name: "Latvia", OfficialName: "Republic of Latvia", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"EUR"}, TLD: ".lv", Capital: "Riga"},
+	{Alpha2: "LY", Alpha3: "LBY", Numeric: "434", Name: "Libya", OfficialName: "Libya", Region: "Africa", SubRegion: "Northern Africa", Currencies: []string{"LYD"}, TLD: ".ly", Capital: "Tripoli"},
+	{Alpha2: "MA", Alpha3: "MAR", Numeric: "504", Name: "Morocco", OfficialName: "Kingdom of Morocco", Region: "Africa", SubRegion: "Northern Africa", Currencies: []string{"MAD"}, TLD: ".ma", Capital: "Rabat"},
+	{Alpha2: "MC", Alpha3: "MCO", Numeric: "492", Name: "Monaco", OfficialName: "Principality of Monaco", Region: "Europe", SubRegion: "Western Europe", Currencies: []string{"EUR"}, TLD: ".mc", Capital: "Monaco"},
+	{Alpha2: "MD", Alpha3: "MDA", Numeric: "498", Name: "Moldova, Republic of", OfficialName: "Republic of Moldova", CommonName: "Moldova", Region: "Europe", SubRegion: "Eastern Europe", Currencies: []string{"MDL"}, TLD: ".md", Capital: "Chișinău"},
+	{Alpha2: "ME", Alpha3: "MNE", Numeric: "499", Name: "Montenegro", OfficialName: "Montenegro", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"EUR"}, TLD: ".me", Capital: "Podgorica"},
+	{Alpha2: "MF", Alpha3: "MAF", Numeric: "663", Name: "Saint Martin (French part)", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"EUR"}, Capital: "Marigot"},
+	{Alpha2: "MG", Alpha3: "MDG", Numeric: "450", Name: "Madagascar", OfficialName: "Republic of Madagascar", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"MGA"}, TLD: ".mg", Capital: "Antananarivo"},
+	{Alpha2: "MH", Alpha3: "MHL", Numeric: "584", Name: "Marshall Islands", OfficialName: "Republic of the Marshall Islands", Region: "Oceania", SubRegion: "Micronesia", Currencies: []string{"USD"}, TLD: ".mh", Capital: "Majuro"},
+	{Alpha2: "MK", Alpha3: "MKD", Numeric: "807", Name: "North Macedonia", OfficialName: "Republic of North Macedonia", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"MKD"}, TLD: ".mk", Capital: "Skopje"},
+	{Alpha2: "ML", Alpha3: "MLI", Numeric: "466", Name: "Mali", OfficialName: "Republic of Mali", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"XOF"}, TLD: ".ml", Capital: "Bamako"},
+	{Alpha2: "MM", Alpha3: "MMR", Numeric: "104", Name: "Myanmar", OfficialName: "Republic of Myanmar", Region: "Asia", SubRegion: "South-eastern Asia", Currencies: []string{"MMK"}, TLD: ".mm", Capital: "Naypyidaw"},
+	{Alpha2: "MN", Alpha3: "MNG", Numeric: "496", Name: "Mongolia", Region: "Asia", SubRegion: "Eastern Asia", Currencies: []string{"MNT"}, TLD: ".mn", Capital: "Ulaanbaatar"},
+	{Alpha2: "MO", Alpha3: "MAC", Numeric: "446", Name: "Macao", OfficialName: "Macao Special Administrative Region of China", Region: "Asia", SubRegion: "Eastern Asia", Currencies: []string{"MOP"}, TLD: ".mo"},
+	{Alpha2: "MP", Alpha3: "MNP", Numeric: "580", Name: "Northern Mariana Islands", OfficialName: "Commonwealth of the Northern Mariana Islands", Region: "Oceania", SubRegion: "Micronesia", Currencies: []string{"USD"}, TLD: ".mp", Capital: "Saipan"},
+	{Alpha2: "MQ", Alpha3: "MTQ", Numeric: "474", Name: "Martinique", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"EUR"}, TLD: ".mq", Capital: "Fort-de-France"},
+	{Alpha2: "MR", Alpha3: "MRT", Numeric: "478", Name: "Mauritania", OfficialName: "Islamic Republic of Mauritania", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"MRU"}, TLD: ".mr", Capital: "Nouakchott"},
+	{Alpha2: "MS", Alpha3: "MSR", Numeric: "500", Name: "Montserrat", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"XCD"}, TLD: ".ms", Capital: "Plymouth"},
+	{Alpha2: "MT", Alpha3: "MLT", Numeric: "470", Name: "Malta", OfficialName: "Republic of Malta", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"EUR"}, TLD: ".mt", Capital: "Valletta"},
+	{Alpha2: "MU", Alpha3: "MUS", Numeric: "480", Name: "Mauritius", OfficialName: "Republic of Mauritius", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"MUR"}, TLD: ".mu", Capital: "Port Louis"},
+	{Alpha2: "MV", Alpha3: "MDV", Numeric: "462", Name: "Maldives", OfficialName: "Republic of Maldives", Region: "Asia", SubRegion: "Southern Asia", Currencies: []string{"MVR"}, TLD: ".mv", Capital: "Malé"},
+	{Alpha2: "MW", Alpha3: "MWI", Numeric: "454", Name: "Malawi", OfficialName: "Republic of Malawi", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"MWK"}, TLD: ".mw", Capital: "Lilongwe"},
+	{Alpha2: "MX", Alpha3: "MEX", Numeric: "484", Name: "Mexico", OfficialName: "United Mexican States", Region: "Americas", SubRegion: "Central America", Currencies: []string{"MXN"}, TLD: ".mx", Capital: "Mexico City"},
+	{Alpha2: "MY", Alpha3: "MYS", Numeric: "458", Name: "Malaysia", Region: "Asia", SubRegion: "South-eastern Asia", Currencies: []string{"MYR"}, TLD: ".my", Capital: "Kuala Lumpur"},
+	{Alpha2: "MZ", Alpha3: "MOZ", Numeric: "508", Name: "Mozambique", OfficialName: "Republic of Mozambique", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"MZN"}, TLD: ".mz", Capital: "Maputo"},
+	{Alpha2: "NA", Alpha3: "NAM", Numeric: "516", Name: "Namibia", OfficialName: "Republic of Namibia", Region: "Africa", SubRegion: "Southern Africa", Currencies: []string{"NAD", "ZAR"}, TLD: ".na", Capital: "Windhoek"},
+	{Alpha2: "NC", Alpha3: "NCL", Numeric: "540", Name: "New Caledonia", Region: "Oceania", SubRegion: "Melanesia", Currencies: []string{"XPF"}, TLD: ".nc", Capital: "Nouméa"},
+	{Alpha2: "NE", Alpha3: "NER", Numeric: "562", Name: "Niger", OfficialName: "Republic of the Niger", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"XOF"}, TLD: ".ne", Capital: "Niamey"},
+	{Alpha2: "NF", Alpha3: "NFK", Numeric: "574", Name: "Norfolk Island", Region: "Oceania", SubRegion: "Australia and New Zealand", Currencies: []string{"AUD"}, TLD: ".nf", Capital: "Kingston"},
+	{Alpha2: "NG", Alpha3: "NGA", Numeric: "566", Name: "Nigeria", OfficialName: "Federal Republic of Nigeria", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"NGN"}, TLD: ".ng", Capital: "Abuja"},
+	{Alpha2: "NI", Alpha3: "NIC", Numeric: "558", Name: "Nicaragua", OfficialName: "Republic of Nicaragua", Region: "Americas", SubRegion: "Central America", Currencies: []string{"NIO"}, TLD: ".ni", Capital: "Managua"},
+	{Alpha2: "NL", Alpha3: "NLD", Numeric: "528", Name: "Netherlands", OfficialName: "Kingdom of the Netherlands", Region: "Europe", SubRegion: "Western Europe", Currencies: []string{"EUR"}, TLD: ".nl", Capital: "Amsterdam"},
+	{Alpha2: "NO", Alpha3: "NOR", Numeric: "578", Name: "Norway", OfficialName: "Kingdom of Norway", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"NOK"}, TLD: ".no", Capital: "Oslo"},
+	{Alpha2: "NP", Alpha3: "NPL", Numeric: "524", Name: "Nepal", OfficialName: "Federal Democratic Republic of Nepal", Region: "Asia", SubRegion: "Southern Asia", Currencies: []string{"NPR"}, TLD: ".np", Capital: "Kathmandu"},
+	{Alpha2: "NR", Alpha3: "NRU", Numeric: "520", Name: "Nauru", OfficialName: "Republic of Nauru", Region: "Oceania", SubRegion: "Micronesia", Currencies: []string{"AUD"}, TLD: ".nr", Capital: "Yaren"},
+	{Alpha2: "NU", Alpha3: "NIU", Numeric: "570", Name: "Niue", OfficialName: "Niue", Region: "Oceania", SubRegion: "Polynesia", Currencies: []string{"NZD"}, TLD: ".nu", Capital: "Alofi"},
+	{Alpha2: "NZ", Alpha3: "NZL", Numeric: "554", Name: "New Zealand", Region: "Oceania", SubRegion: "Australia and New Zealand", Currencies: []string{"NZD"}, TLD: ".nz", Capital: "Wellington"},
+	{Alpha2: "OM", Alpha3: "OMN", Numeric: "512", Name: "Oman", OfficialName: "Sultanate of Oman", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"OMR"}, TLD: ".om", Capital: "Muscat"},
+	{Alpha2: "PA", Alpha3: "PAN", Numeric: "591", Name: "Panama", OfficialName: "Republic of Panama", Region: "Americas", SubRegion: "Central America", Currencies: []string{"PAB", "USD"}, TLD: ".pa", Capital: "Panama City"},
+	{Alpha2: "PE", Alpha3: "PER", Numeric: "604", Name: "Peru", OfficialName: "Republic of Peru", Region: "Americas", SubRegion: "South America", Currencies: []string{"PEN"}, TLD: ".pe", Capital: "Lima"},
+	{Alpha2: "PF", Alpha3: "PYF", Numeric: "258", Name: "French Polynesia", Region: "Oceania", SubRegion: "Polynesia", Currencies: []string{"XPF"}, TLD: ".pf", Capital: "Papeete"},
+	{Alpha2: "PG", Alpha3: "PNG", Numeric: "598", Name: "Papua New Guinea", OfficialName: "Independent State of Papua New Guinea", Region: "Oceania", SubRegion: "Melanesia", Currencies: []string{"PGK"}, TLD: ".pg", Capital: "Port Moresby"},
+	{Alpha2: "PH", Alpha3: "PHL", Numeric: "608", Name: "Philippines", OfficialName: "Republic of the Philippines", Region: "Asia", SubRegion: "South-eastern Asia", Currencies: []string{"PHP"}, TLD: ".ph", Capital: "Manila"},
+	{Alpha2: "PK", Alpha3: "PAK", Numeric: "586", Name: "Pakistan", OfficialName: "Islamic Republic of Pakistan", Region: "Asia", SubRegion: "Southern Asia", Currencies: []string{"PKR"}, TLD: ".pk", Capital: "Islamabad"},
+	{Alpha2: "PL", Alpha3: "POL", Numeric: "616", Name: "Poland", OfficialName: "Republic of Poland", Region: "Europe", SubRegion: "Eastern Europe", Currencies: []string{"PLN"}, TLD: ".pl", Capital: "Warsaw"},
+	{Alpha2: "PM", Alpha3: "SPM", Numeric: "666", Name: "Saint Pierre and Miquelon", Region: "Americas", SubRegion: "Northern America", Currencies: []string{"EUR"}, TLD: ".pm", Capital: "Saint-Pierre"},
+	{Alpha2: "PN", Alpha3: "PCN", Numeric: "612", Name: "Pitcairn", Region: "Oceania", SubRegion: "Polynesia", Currencies: []string{"NZD"}, TLD: ".pn", Capital: "Adamstown"},
+	{Alpha2: "PR", Alpha3: "PRI", Numeric: "630", Name: "Puerto Rico", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"USD"}, TLD: ".pr", Capital: "San Juan"},
+	{Alpha2: "PS", Alpha3: "PSE", Numeric: "275", Name: "Palestine, State of", OfficialName: "the State of Palestine", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"ILS", "JOD"}, TLD: ".ps"},
+	{Alpha2: "PT", Alpha3: "PRT", Numeric: "620", Name: "Portugal", OfficialName: "Portuguese Republic", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"EUR"}, TLD: ".pt", Capital: "Lisbon"},
+	{Alpha2: "PW", Alpha3: "PLW", Numeric: "585", Name: "Palau", OfficialName: "Republic of Palau", Region: "Oceania", SubRegion: "Micronesia", Currencies: []string{"USD"}, TLD: ".pw", Capital: "Ngerulmud"},
+	{Alpha2: "PY", Alpha3: "PRY", Numeric: "600", Name: "Paraguay", OfficialName: "Republic of Paraguay", Region: "Americas", SubRegion: "South America", Currencies: []string{"PYG"}, TLD: ".py", Capital: "Asunción"},
+	{Alpha2: "QA", Alpha3: "QAT", Numeric: "634", Name: "Qatar", OfficialName: "State of Qatar", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"QAR"}, TLD: ".qa", Capital: "Doha"},
+	{Alpha2: "RE", Alpha3: "REU", Numeric: "638", Name: "Réunion", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"EUR"}, TLD: ".re", Capital: "Saint-Denis"},
+	{Alpha2: "RO", Alpha3: "ROU", Numeric: "642", Name: "Romania", Region: "Europe", SubRegion: "Eastern Europe", Currencies: []string{"RON"}, TLD: ".ro", Capital: "Bucharest"},
+	{Alpha2: "RS", Alpha3: "SRB", Numeric: "688", Name: "Serbia", OfficialName: "Republic of Serbia", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"RSD"}, TLD: ".rs", Capital: "Belgrade"},
+	{Alpha2: "RU", Alpha3: "RUS", Numeric: "643", Name: "Russian Federation", Region: "Europe", SubRegion: "Eastern Europe", Currencies: []string{"RUB"}, TLD: ".ru", Capital: "Moscow"},
+	{Alpha2: "RW", Alpha3: "RWA", Numeric: "646", Name: "Rwanda", OfficialName: "Rwandese Republic", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"RWF"}, TLD: ".rw", Capital: "Kigali"},
+	{Alpha2: "SA", Alpha3: "SAU", Numeric: "682", Name: "Saudi Arabia", OfficialName: "Kingdom of Saudi Arabia", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"SAR"}, TLD: ".sa", Capital: "Riyadh"},
+	{Alpha2: "SB", Alpha3: "SLB", Numeric: "090", Name: "Solomon Islands", Region: "Oceania", SubRegion: "Melanesia", Currencies: []string{"SBD"}, TLD: ".sb", Capital: "Honiara"},
+	{Alpha2: "SC", Alpha3: "SYC", Numeric: "690", Name: "Seychelles", OfficialName: "Republic of Seychelles", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"SCR"}, TLD: ".sc", Capital: "Victoria"},
+	{Alpha2: "SD", Alpha3: "SDN", Numeric: "729", Name: "Sudan", OfficialName: "Republic of the Sudan", Region: "Africa", SubRegion: "Northern Africa", Currencies: []string{"SDG"}, TLD: ".sd", Capital: "Khartoum"},
+	{Alpha2: "SE", Alpha3: "SWE", Numeric: "752", Name: "Sweden", OfficialName: "Kingdom of Sweden", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"SEK"}, TLD: ".se", Capital: "Stockholm"},
+	{Alpha2: "SG", Alpha3: "SGP", Numeric: "702", Name: "Singapore", OfficialName: "Republic of Singapore", Region: "Asia", SubRegion: "South-eastern Asia", Currencies: []string{"SGD"}, TLD: ".sg", Capital: "Singapore"},
+	{Alpha2: "SH", Alpha3: "SHN", Numeric: "654", Name: "Saint Helena, Ascension and Tristan da Cunha", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"SHP"}, TLD: ".sh", Capital: "Jamestown"},
+	{Alpha2: "SI", Alpha3: "SVN", Numeric: "705", Name: "Slovenia", OfficialName: "Republic of Slovenia", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"EUR"}, TLD: ".si", Capital: "Ljubljana"},
+	{Alpha2: "SJ", Alpha3: "SJM", Numeric: "744", Name: "Svalbard and Jan Mayen", Region: "Europe", SubRegion: "Northern Europe", Currencies: []string{"NOK"}, TLD: ".sj", Capital: "Longyearbyen"},
+	{Alpha2: "SK", Alpha3: "SVK", Numeric: "703", Name: "Slovakia", OfficialName: "Slovak Republic", Region: "Europe", SubRegion: "Eastern Europe", Currencies: []string{"EUR"}, TLD: ".sk", Capital: "Bratislava"},
+	{Alpha2: "SL", Alpha3: "SLE", Numeric: "694", Name: "Sierra Leone", OfficialName: "Republic of Sierra Leone", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"SLE"}, TLD: ".sl", Capital: "Freetown"},
+	{Alpha2: "SM", Alpha3: "SMR", Numeric: "674", Name: "San Marino", OfficialName: "Republic of San Marino", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"EUR"}, TLD: ".sm", Capital: "San Marino"},
+	{Alpha2: "SN", Alpha3: "SEN", Numeric: "686", Name: "Senegal", OfficialName: "Republic of Senegal", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"XOF"}, TLD: ".sn", Capital: "Dakar"},
+	{Alpha2: "SO", Alpha3: "SOM", Numeric: "706", Name: "Somalia", OfficialName: "Federal Republic of Somalia", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"SOS"}, TLD: ".so", Capital: "Mogadishu"},
+	{Alpha2: "SR", Alpha3: "SUR", Numeric: "740", Name: "Suriname", OfficialName: "Republic of Suriname", Region: "Americas", SubRegion: "South America", Currencies: []string{"SRD"}, TLD: ".sr", Capital: "Paramaribo"},
+	{Alpha2: "SS", Alpha3: "SSD", Numeric: "728", Name: "South Sudan", OfficialName: "Republic of South Sudan", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"SSP"}, TLD: ".ss", Capital: "Juba"},
+	{Alpha2: "ST", Alpha3: "STP", Numeric: "678", Name: "Sao Tome and Principe", OfficialName: "Democratic Republic of Sao Tome and Principe", Region: "Africa", SubRegion: "Middle Africa", Currencies: []string{"STN"}, TLD: ".st", Capital: "São Tomé"},
+	{Alpha2: "SV", Alpha3: "SLV", Numeric: "222", Name: "El Salvador", OfficialName: "Republic of El Salvador", Region: "Americas", SubRegion: "Central America", Currencies: []string{"USD"}, TLD: ".sv", Capital: "San Salvador"},
+	{Alpha2: "SX", Alpha3: "SXM", Numeric: "534", Name: "Sint Maarten (Dutch part)", OfficialName: "Sint Maarten (Dutch part)", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"XCG"}, TLD: ".sx", Capital: "Philipsburg"},
+	{Alpha2: "SY", Alpha3: "SYR", Numeric: "760", Name: "Syrian Arab Republic", CommonName: "Syria", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"SYP"}, TLD: ".sy", Capital: "Damascus"},
+	{Alpha2: "SZ", Alpha3: "SWZ", Numeric: "748", Name: "Eswatini", OfficialName: "Kingdom of Eswatini", Region: "Africa", SubRegion: "Southern Africa", Currencies: []string{"SZL", "ZAR"}, TLD: ".sz", Capital: "Mbabane"},
+	{Alpha2: "TC", Alpha3: "TCA", Numeric: "796", Name: "Turks and Caicos Islands", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"USD"}, TLD: ".tc", Capital: "Cockburn Town"},
+	{Alpha2: "TD", Alpha3: "TCD", Numeric: "148", Name: "Chad", OfficialName: "Republic of Chad", Region: "Africa", SubRegion: "Middle Africa", Currencies: []string{"XAF"}, TLD: ".td", Capital: "N'Djamena"},
+	{Alpha2: "TF", Alpha3: "ATF", Numeric: "260", Name: "French Southern Territories", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"EUR"}, TLD: ".tf", Capital: "Port-aux-Français"},
+	{Alpha2: "TG", Alpha3: "TGO", Numeric: "768", Name: "Togo", OfficialName: "Togolese Republic", Region: "Africa", SubRegion: "Western Africa", Currencies: []string{"XOF"}, TLD: ".tg", Capital: "Lomé"},
+	{Alpha2: "TH", Alpha3: "THA", Numeric: "764", Name: "Thailand", OfficialName: "Kingdom of Thailand", Region: "Asia", SubRegion: "South-eastern Asia", Currencies: []string{"THB"}, TLD: ".th", Capital: "Bangkok"},
+	{Alpha2: "TJ", Alpha3: "TJK", Numeric: "762", Name: "Tajikistan", OfficialName: "Republic of Tajikistan", Region: "Asia", SubRegion: "Central Asia", Currencies: []string{"TJS"}, TLD: ".tj", Capital: "Dushanbe"},
+	{Alpha2: "TK", Alpha3: "TKL", Numeric: "772", Name: "Tokelau", Region: "Oceania", SubRegion: "Polynesia", Currencies: []string{"NZD"}, TLD: ".tk"},
+	{Alpha2: "TL", Alpha3: "TLS", Numeric: "626", Name: "Timor-Leste", OfficialName: "Democratic Republic of Timor-Leste", Region: "Asia", SubRegion: "South-eastern Asia", Currencies: []string{"USD"}, TLD: ".tl", Capital: "Dili"},
+	{Alpha2: "TM", Alpha3: "TKM", Numeric: "795", Name: "Turkmenistan", Region: "Asia", SubRegion: "Central Asia", Currencies: []string{"TMT"}, TLD: ".tm", Capital: "Ashgabat"},
+	{Alpha2: "TN", Alpha3: "TUN", Numeric: "788", Name: "Tunisia", OfficialName: "Republic of Tunisia", Region: "Africa", SubRegion: "Northern Africa", Currencies: []string{"TND"}, TLD: ".tn", Capital: "Tunis"},
+	{Alpha2: "TO", Alpha3: "TON", Numeric: "776", Name: "Tonga", OfficialName: "Kingdom of Tonga", Region: "Oceania", SubRegion: "Polynesia", Currencies: []string{"TOP"}, TLD: ".to", Capital: "Nuku'alofa"},
+	{Alpha2: "TR", Alpha3: "TUR", Numeric: "792", Name: "Türkiye", OfficialName: "Republic of Türkiye", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"TRY"}, TLD: ".tr", Capital: "Ankara"},
+	{Alpha2: "TT", Alpha3: "TTO", Numeric: "780", Name: "Trinidad and Tobago", OfficialName: "Republic of Trinidad and Tobago", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"TTD"}, TLD: ".tt", Capital: "Port of Spain"},
+	{Alpha2: "TV", Alpha3: "TUV", Numeric: "798", Name: "Tuvalu", Region: "Oceania", SubRegion: "Polynesia", Currencies: []string{"AUD"}, TLD: ".tv", Capital: "Funafuti"},
+	{Alpha2: "TW", Alpha3: "TWN", Numeric: "158", Name: "Taiwan, Province of China", OfficialName: "Taiwan, Province of China", CommonName: "Taiwan", Region: "Asia", SubRegion: "Eastern Asia", Currencies: []string{"TWD"}, TLD: ".tw", Capital: "Taipei"},
+	{Alpha2: "TZ", Alpha3: "TZA", Numeric: "834", Name: "Tanzania, United Republic of", OfficialName: "United Republic of Tanzania", CommonName: "Tanzania", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"TZS"}, TLD: ".tz", Capital: "Dodoma"},
+	{Alpha2: "UA", Alpha3: "UKR", Numeric: "804", Name: "Ukraine", Region: "Europe", SubRegion: "Eastern Europe", Currencies: []string{"UAH"}, TLD: ".ua", Capital: "Kyiv"},
+	{Alpha2: "UG", Alpha3: "UGA", Numeric: "800", Name: "Uganda", OfficialName: "Republic of Uganda", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"UGX"}, TLD: ".ug", Capital: "Kampala"},
+	{Alpha2: "UM", Alpha3: "UMI", Numeric: "581", Name: "United States Minor Outlying Islands", Region: "Oceania", SubRegion: "Micronesia", Currencies: []string{"USD"}},
+	{Alpha2: "US", Alpha3: "USA", Numeric: "840", Name: "United States", OfficialName: "United States of America", Region: "Americas", SubRegion: "Northern America", Currencies: []string{"USD"}, TLD: ".us", Capital: "Washington, D.C."},
+	{Alpha2: "UY", Alpha3: "URY", Numeric: "858", Name: "Uruguay", OfficialName: "Eastern Republic of Uruguay", Region: "Americas", SubRegion: "South America", Currencies: []string{"UYU"}, TLD: ".uy", Capital: "Montevideo"},
+	{Alpha2: "UZ", Alpha3: "UZB", Numeric: "860", Name: "Uzbekistan", OfficialName: "Republic of Uzbekistan", Region: "Asia", SubRegion: "Central Asia", Currencies: []string{"UZS"}, TLD: ".uz", Capital: "Tashkent"},
+	{Alpha2: "VA", Alpha3: "VAT", Numeric: "336", Name: "Holy See (Vatican City State)", Region: "Europe", SubRegion: "Southern Europe", Currencies: []string{"EUR"}, TLD: ".va", Capital: "Vatican City"},
+	{Alpha2: "VC", Alpha3: "VCT", Numeric: "670", Name: "Saint Vincent and the Grenadines", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"XCD"}, TLD: ".vc", Capital: "Kingstown"},
+	{Alpha2: "VE", Alpha3: "VEN", Numeric: "862", Name: "Venezuela, Bolivarian Republic of", OfficialName: "Bolivarian Republic of Venezuela", CommonName: "Venezuela", Region: "Americas", SubRegion: "South America", Currencies: []string{"VES"}, TLD: ".ve", Capital: "Caracas"},
+	{Alpha2: "VG", Alpha3: "VGB", Numeric: "092", Name: "Virgin Islands, British", OfficialName: "British Virgin Islands", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"USD"}, TLD: ".vg", Capital: "Road Town"},
+	{Alpha2: "VI", Alpha3: "VIR", Numeric: "850", Name: "Virgin Islands, U.S.", OfficialName: "Virgin Islands of the United States", Region: "Americas", SubRegion: "Caribbean", Currencies: []string{"USD"}, TLD: ".vi", Capital: "Charlotte Amalie"},
+	{Alpha2: "VN", Alpha3: "VNM", Numeric: "704", Name: "Viet Nam", OfficialName: "Socialist Republic of Viet Nam", CommonName: "Vietnam", Region: "Asia", SubRegion: "South-eastern Asia", Currencies: []string{"VND"}, TLD: ".vn", Capital: "Hanoi"},
+	{Alpha2: "VU", Alpha3: "VUT", Numeric: "548", Name: "Vanuatu", OfficialName: "Republic of Vanuatu", Region: "Oceania", SubRegion: "Melanesia", Currencies: []string{"VUV"}, TLD: ".vu", Capital: "Port Vila"},
+	{Alpha2: "WF", Alpha3: "WLF", Numeric: "876", Name: "Wallis and Futuna", Region: "Oceania", SubRegion: "Polynesia", Currencies: []string{"XPF"}, TLD: ".wf", Capital: "Mata-Utu"},
+	{Alpha2: "WS", Alpha3: "WSM", Numeric: "882", Name: "Samoa", OfficialName: "Independent State of Samoa", Region: "Oceania", SubRegion: "Polynesia", Currencies: []string{"WST"}, TLD: ".ws", Capital: "Apia"},
+	{Alpha2: "YE", Alpha3: "YEM", Numeric: "887", Name: "Yemen", OfficialName: "Republic of Yemen", Region: "Asia", SubRegion: "Western Asia", Currencies: []string{"YER"}, TLD: ".ye", Capital: "Sana'a"},
+	{Alpha2: "YT", Alpha3: "MYT", Numeric: "175", Name: "Mayotte", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"EUR"}, TLD: ".yt", Capital: "Mamoudzou"},
+	{Alpha2: "ZA", Alpha3: "ZAF", Numeric: "710", Name: "South Africa", OfficialName: "Republic of South Africa", Region: "Africa", SubRegion: "Southern Africa", Currencies: []string{"ZAR"}, TLD: ".za", Capital: "Pretoria"},
+	{Alpha2: "ZM", Alpha3: "ZMB", Numeric: "894", Name: "Zambia", OfficialName: "Republic of Zambia", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"ZMW"}, TLD: ".zm", Capital: "Lusaka"},
+	{Alpha2: "ZW", Alpha3: "ZWE", Numeric: "716", Name: "Zimbabwe", OfficialName: "Republic of Zimbabwe", Region: "Africa", SubRegion: "Eastern Africa", Currencies: []string{"ZWG"}, TLD: ".zw", Capital: "Harare"},
+}
@@ -0,0 +1,347 @@
+package main
+
+import (
+	"log"
+	"strings"
+	"unicode"
+
+	countrycodes "github.com/willy182/goshare/country_codes"
+)
+
+// numberFormatData data structure of per country grouping patterns
+type numberFormatData struct {
+	formats []countrycodes.NumberFormat
+}
+
+// withNumberFormats function for setting number formats of iso3166 datas,
+// data by alpha2 takes precedence over data shared by calling code
+func withNumberFormats(iso3166Datas []countrycodes.ISO3166) []countrycodes.ISO3166 {
+	for k, i := range iso3166Datas {
+		data, ok := numberFormatDatas[i.Alpha2]
+		if !ok {
+			data, ok = callingCodeFormatDatas[i.CountryCode]
+		}
+		if ok {
+			iso3166Datas[k].NumberFormats = data.formats
+		}
+	}
+	return iso3166Datas
+}
+
+// callingCodeFormatDatas format data shared by every country of a calling code
+var callingCodeFormatDatas = map[string]numberFormatData{
+	// North American Numbering Plan
+	"1": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(\d{3})(\d{3})(\d{4})$`, Format: "($1) $2-$3", InternationalFormat: "$1-$2-$3"},
+	}},
+	"7": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(\d{3})(\d{3})(\d{2})(\d{2})$`, Format: "$NP ($1) $2-$3-$4", InternationalFormat: "$1 $2-$3-$4"},
+	}},
+}
+
+// numberFormatDatas format data by alpha2
+var numberFormatDatas = map[string]numberFormatData{
+	"AU": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(4\d{2})(\d{3})(\d{3})$`, Format: "$NP$1 $2 $3"},
+	}},
+	"CN": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(1\d{2})(\d{4})(\d{4})$`, Format: "$1 $2 $3"},
+	}},
+	"DE": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(1\d{2})(\d{7,8})$`, Format: "$NP$1 $2"},
+	}},
+	"FR": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(\d)(\d{2})(\d{2})(\d{2})(\d{2})$`, Format: "$NP$1 $2 $3 $4 $5"},
+	}},
+	"GB": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(7\d{3})(\d{6})$`, Format: "$NP$1 $2"},
+	}},
+	"HK": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(\d{4})(\d{4})$`, Format: "$1 $2"},
+	}},
+	"ID": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(8\d{2})(\d{3,4})(\d{3,4})$`, Format: "$NP$1-$2-$3"},
+		{Pattern: `^(2\d)(\d{3,4})(\d{4})$`, Format: "($NP$1) $2-$3", InternationalFormat: "$1-$2-$3"},
+	}},
+	"IN": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(\d{5})(\d{5})$`, Format: "$NP$1 $2"},
+	}},
+	"JP": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(\d{2})(\d{4})(\d{4})$`, Format: "$NP$1-$2-$3"},
+	}},
+	"MY": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(11)(\d{4})(\d{4})$`, Format: "$NP$1-$2 $3"},
+		{Pattern: `^(1\d)(\d{3})(\d{4})$`, Format: "$NP$1-$2 $3"},
+	}},
+	"NL": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(6)(\d{8})$`, Format: "$NP$1 $2"},
+	}},
+	"PH": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(\d{3})(\d{3})(\d{4})$`, Format: "$NP$1 $2 $3"},
+	}},
+	"SG": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(\d{4})(\d{4})$`, Format: "$1 $2"},
+	}},
+	"TH": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(\d{2})(\d{3})(\d{4})$`, Format: "$NP$1 $2 $3"},
+	}},
+	"VN": {formats: []countrycodes.NumberFormat{
+		{Pattern: `^(\d{3})(\d{3})(\d{3})$`, Format: "$NP$1 $2 $3"},
+	}},
+}
+
+// defaultNationalPrefix trunk prefix of countries missing from nationalPrefixDatas and callingCodeNationalPrefixDatas
+const defaultNationalPrefix = "0"
+
+// defaultInternationalPrefixes international dialing prefixes of countries missing from
+// internationalPrefixDatas and callingCodeInternationalPrefixDatas
+var defaultInternationalPrefixes = []string{"00"}
+
+// withDialingPrefixes function for setting national trunk prefix and international dialing prefixes of iso3166 datas,
+// data by alpha2 takes precedence over data shared by calling code
+func withDialingPrefixes(iso3166Datas []countrycodes.ISO3166) []countrycodes.ISO3166 {
+	for k, i := range iso3166Datas {
+		nationalPrefix, ok := nationalPrefixDatas[i.Alpha2]
+		if !ok {
+			nationalPrefix, ok = callingCodeNationalPrefixDatas[i.CountryCode]
+		}
+		if !ok {
+			nationalPrefix = defaultNationalPrefix
+		}
+		iso3166Datas[k].NationalPrefix = nationalPrefix
+
+		internationalPrefixes, ok := internationalPrefixDatas[i.Alpha2]
+		if !ok {
+			internationalPrefixes, ok = callingCodeInternationalPrefixDatas[i.CountryCode]
+		}
+		if !ok {
+			internationalPrefixes = defaultInternationalPrefixes
+		}
+		iso3166Datas[k].InternationalPrefixes = internationalPrefixes
+	}
+	return iso3166Datas
+}
+
+// callingCodeNationalPrefixDatas trunk prefix shared by every country of a calling code
+var callingCodeNationalPrefixDatas = map[string]string{
+	// North American Numbering Plan
+	"1": "1",
+	// Russia and Kazakhstan
+	"7": "8",
+}
+
+// nationalPrefixDatas trunk prefix by alpha2, empty for countries without trunk prefix whose
+// numbers are dialed the same way nationally and after the calling code
+var nationalPrefixDatas = map[string]string{
+	// Europe
+	"AD": "", "CY": "", "CZ": "", "DK": "", "EE": "", "ES": "", "FO": "", "GI": "", "GR": "", "IS": "",
+	"IT": "", "LU": "", "LV": "", "MC": "", "MT": "", "NO": "", "PL": "", "PT": "", "SM": "",
+	"BY": "8", "HU": "06",
+	// Asia
+	"BH": "", "BN": "", "BT": "", "HK": "", "KW": "", "MO": "", "MV": "", "OM": "", "QA": "", "SG": "", "TL": "",
+	"TM": "8",
+	// Africa
+	"BF": "", "BI": "", "CF": "", "CG": "", "CI": "", "CM": "", "CV": "", "DJ": "", "GA": "", "GM": "", "GN": "",
+	"GQ": "", "GW": "", "KM": "", "ML": "", "MR": "", "MU": "", "NE": "", "SC": "", "SN": "", "ST": "", "TD": "",
+	"TG": "",
+	// Americas
+	"AW": "", "BZ": "", "CR": "", "GL": "", "GT": "", "GY": "", "HN": "", "HT": "", "NI": "", "PA": "", "SR": "",
+	"SV": "",
+	// Oceania
+	"CK": "", "FJ": "", "FM": "", "NC": "", "NR": "", "NU": "", "PF": "", "PG": "", "PW": "", "SB": "", "TK": "",
+	"TO": "", "TV": "", "VU": "", "WF": "", "WS": "",
+	"MH": "1",
+}
+
+// callingCodeInternationalPrefixDatas international dialing prefixes shared by every country of a calling code
+var callingCodeInternationalPrefixDatas = map[string][]string{
+	// North American Numbering Plan
+	"1": {"011"},
+	// Russia and Kazakhstan
+	"7": {"810"},
+}
+
+// internationalPrefixDatas international dialing prefixes by alpha2, the preferred one first
+var internationalPrefixDatas = map[string][]string{
+	"AU": {"0011"},
+	"BY": {"810"},
+	"ID": {"001", "007", "008", "009", "01017"},
+	"JP": {"010"},
+	"KE": {"000"},
+	"KR": {"001", "002", "005", "006", "008"},
+	"MN": {"001"},
+	"SG": {"001", "002", "008"},
+	"TH": {"001", "002", "003", "004", "005", "006", "007", "008", "009"},
+	"TM": {"810"},
+	"TZ": {"000"},
+	"UG": {"000"},
+}
+
+// numberTypeData data structure of per country prefixes of non mobile number types
+// fixedLineAsMobile uses the mobile prefixes for fixed line too
+type numberTypeData struct {
+	fixedLine, tollFree, premiumRate, sharedCost, voip []string
+	fixedLineAsMobile                                  bool
+}
+
+// withNumberTypes function for setting number type prefixes of iso3166 datas,
+// data by alpha2 takes precedence over data shared by calling code
+func withNumberTypes(iso3166Datas []countrycodes.ISO3166) []countrycodes.ISO3166 {
+	for k, i := range iso3166Datas {
+		data, ok := numberTypeDatas[i.Alpha2]
+		if !ok {
+			data, ok = callingCodeTypeDatas[i.CountryCode]
+		}
+		if ok {
+			iso3166Datas[k].FixedLineBeginWith = data.fixedLine
+			iso3166Datas[k].TollFreeBeginWith = data.tollFree
+			iso3166Datas[k].PremiumRateBeginWith = data.premiumRate
+			iso3166Datas[k].SharedCostBeginWith = data.sharedCost
+			iso3166Datas[k].VoIPBeginWith = data.voip
+			if data.fixedLineAsMobile {
+				iso3166Datas[k].FixedLineBeginWith = i.MobileBeginWith
+			}
+		}
+	}
+	return iso3166Datas
+}
+
+// callingCodeTypeDatas number type data shared by every country of a calling code
+var callingCodeTypeDatas = map[string]numberTypeData{
+	// North American Numbering Plan does not tell mobile and landline apart,
+	// every geographic area code is both
+	"1": {
+		fixedLineAsMobile: true,
+		tollFree:          []string{"800", "833", "844", "855", "866", "877", "888"},
+		premiumRate:       []string{"900"},
+	},
+}
+
+// numberTypeDatas number type data by alpha2
+var numberTypeDatas = map[string]numberTypeData{
+	"AU": {
+		fixedLine: []string{"2", "3", "7", "8"},
+	},
+	"GB": {
+		fixedLine:   []string{"1", "2"},
+		tollFree:    []string{"800", "808"},
+		premiumRate: []string{"9"},
+		sharedCost:  []string{"843", "844", "845", "870", "871", "872", "873"},
+		voip:        []string{"56"},
+	},
+	"ID": {
+		fixedLine:   []string{"21", "22", "24", "25", "26", "27", "28", "29", "3", "4", "5", "6", "7", "9"},
+		tollFree:    []string{"800"},
+		premiumRate: []string{"809"},
+		sharedCost:  []string{"804"},
+	},
+	"MY": {
+		fixedLine:   []string{"3", "4", "5", "6", "7", "8", "9"},
+		tollFree:    []string{"1300", "1800"},
+		premiumRate: []string{"1600"},
+	},
+	"SG": {
+		fixedLine: []string{"6"},
+		voip:      []string{"3"},
+	},
+}
+
+// mainCountries alpha2 of the main country of every calling code shared by several countries,
+// it owns numbers whose leading digits do not tell the countries apart, e.g. NANP toll free numbers
+var mainCountries = map[string]string{
+	"1":   "US",
+	"7":   "RU",
+	"44":  "GB",
+	"47":  "NO",
+	"262": "RE",
+	"269": "KM",
+	"358": "FI",
+	"590": "GP",
+}
+
+// withMainCountries function for flagging the main country of every shared calling code
+func withMainCountries(iso3166Datas []countrycodes.ISO3166) []countrycodes.ISO3166 {
+	for k, i := range iso3166Datas {
+		iso3166Datas[k].MainCountryForCode = mainCountries[i.CountryCode] == i.Alpha2
+	}
+	return iso3166Datas
+}
+
+// vanityNumberCallingCodes calling codes whose numbers are advertised with keypad letters
+var vanityNumberCallingCodes = map[string]bool{
+	// North American Numbering Plan, e.g. 1-800-FLOWERS
+	"1": true,
+	// Australia, e.g. 1300 FLOWER
+	"61": true,
+	// New Zealand, e.g. 0800 FLOWERS
+	"64": true,
+}
+
+// withVanityNumbers function for flagging the countries whose numbers may be written with keypad letters
+func withVanityNumbers(iso3166Datas []countrycodes.ISO3166) []countrycodes.ISO3166 {
+	for k, i := range iso3166Datas {
+		iso3166Datas[k].VanityNumbers = vanityNumberCallingCodes[i.CountryCode]
+	}
+	return iso3166Datas
+}
+
+// metadataCountries function for building the countries written to the metadata file, countries with
+// phone metadata come first in iso3166Datas order so the default country of ParsePhone stays first,
+// the legacy phone country names are kept as alternative names
+func metadataCountries() []countrycodes.Country {
+	byAlpha2 := make(map[string]countrycodes.Country, len(countryDatas))
+	for _, c := range countryDatas {
+		c.Subdivisions = subdivisionDatas[c.Alpha2]
+		for language, names := range localizedNameDatas {
+			if name, ok := names[c.Alpha2]; ok {
+				if c.LocalizedNames == nil {
+					c.LocalizedNames = make(map[string]string)
+				}
+				c.LocalizedNames[language] = name
+			}
+		}
+		byAlpha2[c.Alpha2] = c
+	}
+
+	countries := make([]countrycodes.Country, 0, len(countryDatas))
+	for _, i := range iso3166Datas() {
+		c, ok := byAlpha2[i.Alpha2]
+		if !ok {
+			log.Fatalf("no ISO 3166-1 country for %s", i.Alpha2)
+		}
+		if !hasCountryName(c, i.CountryName) {
+			c.AlternativeNames = append(c.AlternativeNames, i.CountryName)
+		}
+		phone := i.PhoneMetadata
+		c.Phone = &phone
+		countries = append(countries, c)
+		delete(byAlpha2, i.Alpha2)
+	}
+
+	for _, c := range countryDatas {
+		if c, ok := byAlpha2[c.Alpha2]; ok {
+			countries = append(countries, c)
+		}
+	}
+	return countries
+}
+
+// hasCountryName function for checking whether name is one of the names of country c, ignoring case and punctuation
+func hasCountryName(c countrycodes.Country, name string) bool {
+	for _, n := range append([]string{c.Name, c.OfficialName, c.CommonName}, c.AlternativeNames...) {
+		if normalizeName(n) == normalizeName(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeName function for upper casing name and dropping anything but letters and combining marks
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if !unicode.IsLetter(r) && !unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, name)
+}
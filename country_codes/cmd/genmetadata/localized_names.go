@@ -0,0 +1,490 @@
+package main
+
+// localizedNameDatas country names by language and alpha2, taken from the translations of the iso-codes project
+// and completed by hand where a translation is missing or outdated. Names equal to the english name are left out
+var localizedNameDatas = map[string]map[string]string{
+	"id": {
+		"AE": "Uni Emirat Arab",
+		"AF": "Afganistan",
+		"AG": "Antigua dan Barbuda",
+		"AQ": "Antartika",
+		"AS": "Samoa Amerika",
+		"AX": "Kepulauan Åland",
+		"BA": "Bosnia dan Herzegovina",
+		"BE": "Belgia",
+		"BO": "Bolivia, Negara Plurinasional",
+		"BQ": "Belanda Karibia",
+		"BR": "Brasil",
+		"BS": "Bahama",
+		"BV": "Pulau Bouvet",
+		"CA": "Kanada",
+		"CC": "Kepulauan Cocos (Keeling)",
+		"CD": "Republik Demokrat Congo",
+		"CF": "Republik Afrika Tengah",
+		"CG": "Republik Kongo",
+		"CH": "Swiss",
+		"CI": "Pantai Gading",
+		"CK": "Kepulauan Cook",
+		"CL": "Chili",
+		"CM": "Kamerun",
+		"CN": "Tiongkok",
+		"CO": "Kolombia",
+		"CR": "Kosta Rika",
+		"CU": "Kuba",
+		"CV": "Tanjung Verde",
+		"CX": "Kepulauan Christmas",
+		"CY": "Siprus",
+		"CZ": "Ceko",
+		"DE": "Jerman",
+		"DM": "Dominika",
+		"DO": "Republik Dominika",
+		"DZ": "Aljazair",
+		"EC": "Ekuador",
+		"EG": "Mesir",
+		"EH": "Sahara Barat",
+		"ES": "Spanyol",
+		"ET": "Etiopia",
+		"FI": "Finlandia",
+		"FK": "Kepulauan Falkland (Malvinas)",
+		"FM": "Federasi Negara-negara Micronesia",
+		"FO": "Kepulauan Faroe",
+		"FR": "Perancis",
+		"GB": "Britania Raya",
+		"GF": "Guyana Perancis",
+		"GQ": "Guinea Khatulistiwa",
+		"GR": "Yunani",
+		"GS": "Georgia Selatan dan Kepulauan Sandwich Selatan",
+		"HM": "Pulau Heard dan Kepulauan McDonald",
+		"HR": "Kroasia",
+		"HU": "Hongaria",
+		"IE": "Irlandia",
+		"IM": "Pulau Man",
+		"IO": "Wilayah Samudra Hindia Britania",
+		"IQ": "Irak",
+		"IR": "Iran, Republik Islam",
+		"IS": "Islandia",
+		"IT": "Italia",
+		"JM": "Jamaika",
+		"JO": "Yordania",
+		"JP": "Jepang",
+		"KG": "Kirgizstan",
+		"KH": "Kamboja",
+		"KM": "Komoro",
+		"KN": "Saint Kitts dan Nevis",
+		"KP": "Korea Utara",
+		"KR": "Korea Selatan",
+		"KY": "Kepulauan Cayman",
+		"LA": "Republik Demokrat Rakyat Laos",
+		"LT": "Lituania",
+		"LU": "Luksemburg",
+		"MA": "Maroko",
+		"MC": "Monako",
+		"MD": "Moldova, Republik",
+		"MF": "Saint Martin (wilayah Prancis)",
+		"MG": "Madagaskar",
+		"MH": "Kepulauan Marshall",
+		"MK": "Makedonia Utara",
+		"MO": "Makau",
+		"MP": "Kepulauan Mariana Utara",
+		"MQ": "Martinik",
+		"MV": "Maladewa",
+		"MX": "Meksiko",
+		"MZ": "Mozambik",
+		"NC": "Kaledonia Baru",
+		"NF": "Pulau Norfolk",
+		"NI": "Nikaragua",
+		"NL": "Belanda",
+		"NO": "Norwegia",
+		"NZ": "Selandia Baru",
+		"PF": "Polinesia Perancis",
+		"PG": "Papua Nugini",
+		"PH": "Filipina",
+		"PL": "Polandia",
+		"PM": "Saint Pierre dan Miquelon",
+		"PN": "Kepulauan Pitcairn",
+		"PR": "Puerto Riko",
+		"PS": "Negara Palestina",
+		"RO": "Rumania",
+		"RU": "Federasi Rusia",
+		"SA": "Arab Saudi",
+		"SB": "Kepulauan Solomon",
+		"SE": "Swedia",
+		"SG": "Singapura",
+		"SH": "Saint Helena, Ascension, dan Tristan da Cunha",
+		"SJ": "Svalbard dan Jan Mayen",
+		"SK": "Slowakia",
+		"SS": "Sudan Selatan",
+		"ST": "Sao Tome dan Principe",
+		"SX": "Sint Maarten (wilayah Belanda)",
+		"SY": "Republik Arab Syria",
+		"TC": "Kepulauan Turks dan Caicos",
+		"TF": "Perancis, Wilayah Bagian Selatan",
+		"TL": "Timor Leste",
+		"TR": "Turki",
+		"TT": "Trinidad dan Tobago",
+		"TW": "Taiwan, Provinsi China",
+		"TZ": "Tanzania",
+		"UA": "Ukraina",
+		"UM": "Kepulauan Terluar Kecil Amerika Serikat",
+		"US": "Amerika Serikat",
+		"VA": "Takhta Suci Vatican (Negara Kota)",
+		"VC": "Saint Vincent dan Grenadines",
+		"VE": "Venezuela, Republik Bolivaria",
+		"VG": "Kepulauan Virgin Inggris",
+		"VI": "Kepulauan Virgin, A.S.",
+		"VN": "Vietnam",
+		"WF": "Wallis dan Futuna",
+		"YE": "Yaman",
+		"ZA": "Afrika Selatan",
+	},
+	"ms": {
+		"AE": "Emiriah Arab Bersatu",
+		"AG": "Antigua dan Barbuda",
+		"AQ": "Antartika",
+		"AS": "Samoa Amerika",
+		"AX": "Kepulauan Åland",
+		"BA": "Bosnia dan Herzegovina",
+		"BO": "Bolivia",
+		"BQ": "Belanda Caribbean",
+		"BV": "Kepulauan Bouvet",
+		"CA": "Kanada",
+		"CC": "Kepulauan Cocos (Keeling)",
+		"CD": "Republik Demokratik Congo",
+		"CF": "Republik Afrika Tengah",
+		"CG": "Kongo",
+		"CI": "Pantai Gading",
+		"CK": "Kepulauan Cook",
+		"CM": "Kamerun",
+		"CV": "Tanjung Verde",
+		"CX": "Kepulauan Christmas",
+		"CZ": "Republik Czech",
+		"DE": "Jerman",
+		"DO": "Republik Dominican",
+		"EG": "Mesir",
+		"EH": "Sahara Barat",
+		"ES": "Sepanyol",
+		"FK": "Kepulauan Falkland (Malvinas)",
+		"FM": "Mikronesia",
+		"FO": "Kepulauan Faroe",
+		"FR": "Perancis",
+		"GF": "Guiana Perancis",
+		"GQ": "Guinea Khatulistiwa",
+		"GR": "Yunani",
+		"GS": "Georgia Selatan dan Kepulauan Sandwich Selatan",
+		"GW": "Guinea Bissau",
+		"HM": "Pulau Heard dan Kepulauan McDonald",
+		"HR": "Kroatia",
+		"HU": "Hungari",
+		"IM": "Pulau Man",
+		"IO": "Wilayah Lautan Hindi British",
+		"IR": "Iran",
+		"IT": "Itali",
+		"JM": "Jamaika",
+		"JP": "Jepun",
+		"KH": "Kemboja",
+		"KN": "Saint Kitts dan Nevis",
+		"KP": "Korea Utara",
+		"KR": "Korea Selatan",
+		"KY": "Kepulauan Cayman",
+		"LA": "Laos",
+		"LB": "Lubnan",
+		"LU": "Luksembourg",
+		"MA": "Maghribi",
+		"MD": "Moldova",
+		"MF": "Saint Martin (bahagian Perancis)",
+		"MG": "Madagaskar",
+		"MH": "Kepulauan Marshall",
+		"MK": "Macedonia Utara",
+		"MP": "Kepulauan Mariana Utara",
+		"MV": "Maldiv",
+		"MX": "Meksiko",
+		"MZ": "Mozambik",
+		"NC": "Kaledonia Baru",
+		"NF": "Pulau Norfolk",
+		"NL": "Belanda",
+		"PF": "Polinesia Perancis",
+		"PH": "Filipina",
+		"PM": "Saint Pierre dan Miquelon",
+		"PS": "Palestin",
+		"RU": "Rusia",
+		"SA": "Arab Saudi",
+		"SB": "Kepulauan Solomon",
+		"SG": "Singapura",
+		"SH": "Saint Helena, Ascension dan Tristan da Cunha",
+		"SJ": "Svalbard dan Jan Mayen",
+		"SR": "Surinam",
+		"SS": "Sudan Selatan",
+		"ST": "Sao Tome dan Principe",
+		"SX": "Sint Maarten (bahagian Belanda)",
+		"SY": "Syria",
+		"TC": "Kepulauan Turks dan Caicos",
+		"TF": "Wilayah Selatan Perancis",
+		"TL": "Timor Leste",
+		"TR": "Turki",
+		"TT": "Trinidad dan Tobago",
+		"TW": "Taiwan",
+		"TZ": "Tanzania",
+		"UM": "Kepulauan Terpencil Kecil Amerika Syarikat",
+		"US": "Amerika Syarikat",
+		"VA": "Kota Vatican",
+		"VC": "Saint Vincent dan Grenadines",
+		"VE": "Venezuela",
+		"VG": "Kepulauan Virgin British",
+		"VI": "Kepulauan Virgin A.S.",
+		"VN": "Vietnam",
+		"WF": "Wallis dan Futuna",
+		"YE": "Yaman",
+		"ZA": "Afrika Selatan",
+	},
+	"th": {
+		"AD": "อันดอร์รา",
+		"AE": "สหรัฐอาหรับเอมิเรตส์",
+		"AF": "อัฟกานิสถาน",
+		"AG": "แอนติกาและบาร์บูดา",
+		"AI": "แองกวิลลา",
+		"AL": "แอลเบเนีย",
+		"AM": "อาร์เมเนีย",
+		"AO": "แองโกลา",
+		"AQ": "แอนตาร์กติกา",
+		"AR": "อาร์เจนตินา",
+		"AS": "อเมริกันซามัว",
+		"AT": "ออสเตรีย",
+		"AU": "ออสเตรเลีย",
+		"AW": "อารูบา",
+		"AX": "หมู่เกาะโอลันด์",
+		"AZ": "อาเซอร์ไบจาน",
+		"BA": "บอสเนียและเฮอร์เซโกวีนา",
+		"BB": "บาร์เบโดส",
+		"BD": "บังกลาเทศ",
+		"BE": "เบลเยียม",
+		"BF": "บูร์กินาฟาโซ",
+		"BG": "บัลแกเรีย",
+		"BH": "บาห์เรน",
+		"BI": "บุรุนดี",
+		"BJ": "เบนิน",
+		"BL": "แซงบาร์เตเลอมี",
+		"BM": "เบอร์มิวดา",
+		"BN": "บรูไนดารุสซาลาม",
+		"BO": "โบลิเวีย, รัฐพหุชาติ",
+		"BQ": "บอแนร์, เซนต์ยูสเตเชียส และ เซบา",
+		"BR": "บราซิล",
+		"BS": "บาฮามาส",
+		"BT": "ภูฏาน",
+		"BV": "เกาะบูเวต์",
+		"BW": "บอตสวานา",
+		"BY": "เบลารุส",
+		"BZ": "เบลีซ",
+		"CA": "แคนาดา",
+		"CC": "หมู่เกาะโคโคส (คีลิง)",
+		"CD": "คองโก, สาธารณรัฐประชาธิปไตย",
+		"CF": "สาธารณรัฐแอฟริกากลาง",
+		"CG": "คองโก",
+		"CH": "สวิตเซอร์แลนด์",
+		"CI": "โกตดิวัวร์",
+		"CK": "หมู่เกาะคุก",
+		"CL": "ชิลี",
+		"CM": "แคเมอรูน",
+		"CN": "จีน",
+		"CO": "โคลอมเบีย",
+		"CR": "คอสตาริกา",
+		"CU": "คิวบา",
+		"CV": "กาบูเวร์ดี",
+		"CW": "คิวราเซา",
+		"CX": "เกาะคริสต์มาส",
+		"CY": "ไซปรัส",
+		"CZ": "ประเทศเช็กเกีย",
+		"DE": "เยอรมนี",
+		"DJ": "จิบูตี",
+		"DK": "เดนมาร์ก",
+		"DM": "โดมินิกา",
+		"DO": "สาธารณรัฐโดมินิกัน",
+		"DZ": "แอลจีเรีย",
+		"EC": "เอกวาดอร์",
+		"EE": "เอสโตเนีย",
+		"EG": "อียิปต์",
+		"EH": "เวสเทิร์นสะฮารา",
+		"ER": "เอริเทรีย",
+		"ES": "สเปน",
+		"ET": "เอธิโอเปีย",
+		"FI": "ฟินแลนด์",
+		"FJ": "ฟิจิ",
+		"FK": "หมู่เกาะฟอล์กแลนด์ (มาลบีนาส)",
+		"FM": "ไมโครนีเซีย",
+		"FO": "หมู่เกาะแฟโร",
+		"FR": "ฝรั่งเศส",
+		"GA": "กาบอง",
+		"GB": "สหราชอาณาจักร",
+		"GD": "เกรนาดา",
+		"GE": "จอร์เจีย",
+		"GF": "เฟรนช์เกียนา",
+		"GG": "เกิร์นซีย์",
+		"GH": "กานา",
+		"GI": "ยิบรอลตาร์",
+		"GL": "กรีนแลนด์",
+		"GM": "แกมเบีย",
+		"GN": "กินี",
+		"GP": "กวาเดอลูป",
+		"GQ": "อิเควทอเรียลกินี",
+		"GR": "กรีซ",
+		"GS": "เกาะเซาท์จอร์เจียและหมู่เกาะเซาท์แซนด์วิช",
+		"GT": "กัวเตมาลา",
+		"GU": "กวม",
+		"GW": "กินีบิสเซา",
+		"GY": "กายอานา",
+		"HK": "ฮ่องกง",
+		"HM": "เกาะเฮิร์ดและหมู่เกาะแมกดอนัลด์",
+		"HN": "ฮอนดูรัส",
+		"HR": "โครเอเชีย",
+		"HT": "เฮติ",
+		"HU": "ฮังการี",
+		"ID": "อินโดนีเซีย",
+		"IE": "ไอร์แลนด์",
+		"IL": "อิสราเอล",
+		"IM": "เกาะแมน",
+		"IN": "อินเดีย",
+		"IO": "บริติชอินเดียนโอเชียนเทร์ริทอรี",
+		"IQ": "อิรัก",
+		"IR": "อิหร่าน, สาธารณรัฐอิสลาม",
+		"IS": "ไอซ์แลนด์",
+		"IT": "อิตาลี",
+		"JE": "เจอร์ซีย์",
+		"JM": "จาเมกา",
+		"JO": "จอร์แดน",
+		"JP": "ญี่ปุ่น",
+		"KE": "เคนยา",
+		"KG": "คีร์กีซสถาน",
+		"KH": "กัมพูชา",
+		"KI": "คิริบาตี",
+		"KM": "คอโมโรส",
+		"KN": "เซนต์คิตส์และเนวิส",
+		"KP": "เกาหลีเหนือ",
+		"KR": "เกาหลีใต้",
+		"KW": "คูเวต",
+		"KY": "หมู่เกาะเคย์แมน",
+		"KZ": "คาซัคสถาน",
+		"LA": "สาธารณรัฐประชาธิปไตยประชาชนลาว",
+		"LB": "เลบานอน",
+		"LC": "เซนต์ลูเซีย",
+		"LI": "ลิกเตนสไตน์",
+		"LK": "ศรีลังกา",
+		"LR": "ไลบีเรีย",
+		"LS": "เลโซโท",
+		"LT": "ลิทัวเนีย",
+		"LU": "ลักเซมเบิร์ก",
+		"LV": "ลัตเวีย",
+		"LY": "ลิเบีย",
+		"MA": "โมร็อกโก",
+		"MC": "โมนาโก",
+		"MD": "มอลโดวา, สาธารณรัฐ",
+		"ME": "มอนเตเนโกร",
+		"MF": "แซงมาร์แตง (ส่วนของฝรั่งเศส)",
+		"MG": "มาดากัสการ์",
+		"MH": "หมู่เกาะมาร์แชลล์",
+		"MK": "มาซิโดเนียเหนือ",
+		"ML": "มาลี",
+		"MM": "พม่า",
+		"MN": "มองโกเลีย",
+		"MO": "มาเก๊า",
+		"MP": "หมู่เกาะนอร์เทิร์นมาเรียนา",
+		"MQ": "มาร์ตินีก",
+		"MR": "มอริเตเนีย",
+		"MS": "มอนต์เซอร์รัต",
+		"MT": "มอลตา",
+		"MU": "มอริเชียส",
+		"MV": "มัลดีฟส์",
+		"MW": "มาลาวี",
+		"MX": "เม็กซิโก",
+		"MY": "มาเลเซีย",
+		"MZ": "โมซัมบิก",
+		"NA": "นามิเบีย",
+		"NC": "นิวแคลิโดเนีย",
+		"NE": "ไนเจอร์",
+		"NF": "เกาะนอร์ฟอล์ก",
+		"NG": "ไนจีเรีย",
+		"NI": "นิการากัว",
+		"NL": "เนเธอร์แลนด์",
+		"NO": "นอร์เวย์",
+		"NP": "เนปาล",
+		"NR": "นาอูรู",
+		"NU": "นีอูเอ",
+		"NZ": "นิวซีแลนด์",
+		"OM": "โอมาน",
+		"PA": "ปานามา",
+		"PE": "เปรู",
+		"PF": "เฟรนช์โปลินีเซีย",
+		"PG": "ปาปัวนิวกินี",
+		"PH": "ฟิลิปปินส์",
+		"PK": "ปากีสถาน",
+		"PL": "โปแลนด์",
+		"PM": "แซงปีแยร์และมีเกอลง",
+		"PN": "พิตแคร์น",
+		"PR": "เปอร์โตริโก",
+		"PS": "ปาเลสไตน์, รัฐ",
+		"PT": "โปรตุเกส",
+		"PW": "ปาเลา",
+		"PY": "ปารากวัย",
+		"QA": "กาตาร์",
+		"RE": "เรอูนียง",
+		"RO": "โรมาเนีย",
+		"RS": "เซอร์เบีย",
+		"RU": "สหพันธรัฐรัสเซีย",
+		"RW": "รวันดา",
+		"SA": "ซาอุดีอาระเบีย",
+		"SB": "หมู่เกาะโซโลมอน",
+		"SC": "เซเชลส์",
+		"SD": "ซูดาน",
+		"SE": "สวีเดน",
+		"SG": "สิงคโปร์",
+		"SH": "เซนต์เฮเลนา, แอสเซนชัน และ ทริสแตน ดา คูนญา",
+		"SI": "สโลวีเนีย",
+		"SJ": "สฟาลบาร์ และ ยานไมเอน",
+		"SK": "สโลวะเกีย",
+		"SL": "เซียร์ราลีโอน",
+		"SM": "ซานมารีโน",
+		"SN": "เซเนกัล",
+		"SO": "โซมาเลีย",
+		"SR": "ซูรินาเม",
+		"SS": "ซูดานใต้",
+		"ST": "เซาตูเมและปรินซิปี",
+		"SV": "เอลซัลวาดอร์",
+		"SX": "เซนต์มาร์ติน (ส่วนของดัตช์)",
+		"SY": "สาธารณรัฐอาหรับซีเรีย",
+		"SZ": "เอสวาตินี",
+		"TC": "หมู่เกาะเติกส์และหมู่เกาะเคคอส",
+		"TD": "ชาด",
+		"TF": "เฟรนช์เซาเทิร์นเทร์ริทอรีส์",
+		"TG": "โตโก",
+		"TH": "ไทย",
+		"TJ": "ทาจิกิสถาน",
+		"TK": "โตเกเลา",
+		"TL": "ติมอร์-เลสเต",
+		"TM": "เติร์กเมนิสถาน",
+		"TN": "ตูนิเซีย",
+		"TO": "ตองกา",
+		"TR": "ตุรกี",
+		"TT": "ตรินิแดดและโตเบโก",
+		"TV": "ตูวาลู",
+		"TW": "ไต้หวัน, จังหวัดของจีน",
+		"TZ": "แทนซาเนีย, สหสาธารณรัฐ",
+		"UA": "ยูเครน",
+		"UG": "ยูกันดา",
+		"UM": "เกาะเล็กรอบนอกของสหรัฐอเมริกา",
+		"US": "สหรัฐ",
+		"UY": "อุรุกวัย",
+		"UZ": "อุซเบกิสถาน",
+		"VA": "นครรัฐวาติกัน",
+		"VC": "เซนต์วินเซนต์และเกรนาดีนส์",
+		"VE": "เวเนซุเอลา, สาธารณรัฐโบลีวาร์แห่ง",
+		"VG": "หมู่เกาะบริติชเวอร์จิน",
+		"VI": "หมู่เกาะเวอร์จินของสหรัฐอเมริกา",
+		"VN": "เวียดนาม",
+		"VU": "วานูอาตู",
+		"WF": "หมู่เกาะวาลลิสและหมู่เกาะฟุตูนา",
+		"WS": "ซามัว",
+		"YE": "เยเมน",
+		"YT": "มายอต",
+		"ZA": "แอฟริกาใต้",
+		"ZM": "แซมเบีย",
+		"ZW": "ซิมบับเว",
+	},
+}
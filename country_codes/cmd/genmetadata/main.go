@@ -0,0 +1,40 @@
+// Command genmetadata writes the country and phone metadata embedded by package countrycodes.
+//
+// Usage:
+//
+//	go run ./cmd/genmetadata -o metadata.json
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+
+	countrycodes "github.com/willy182/goshare/country_codes"
+)
+
+func main() {
+	output := flag.String("o", "", "output file, standard output when empty")
+	flag.Parse()
+
+	datas := metadataCountries()
+
+	var buf bytes.Buffer
+	if err := countrycodes.WriteMetadata(&buf, datas); err != nil {
+		log.Fatal(err)
+	}
+	// make sure the generated file is accepted by the package
+	if _, err := countrycodes.ReadMetadata(bytes.NewReader(buf.Bytes())); err != nil {
+		log.Fatal(err)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(buf.Bytes())
+		return
+	}
+	if err := ioutil.WriteFile(*output, buf.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	countrycodes "github.com/willy182/goshare/country_codes"
+)
+
+func TestMetadataUpToDate(t *testing.T) {
+	embedded, err := ioutil.ReadFile("../../metadata.json")
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.NoError(t, countrycodes.WriteMetadata(&buf, metadataCountries()))
+	assert.Equal(t, string(embedded), buf.String(), "run go generate ./country_codes")
+}
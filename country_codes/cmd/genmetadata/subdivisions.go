@@ -0,0 +1,325 @@
+package main
+
+import countrycodes "github.com/willy182/goshare/country_codes"
+
+// subdivisionDatas ISO 3166-2 subdivisions of the countries we operate in, taken from the iso-codes project
+// with the Indonesian provinces of Papua created in 2022
+var subdivisionDatas = map[string][]countrycodes.Subdivision{
+	"ID": {
+		{Code: "ID-AC", Name: "Aceh", Type: "Province", Parent: "ID-SM"},
+		{Code: "ID-BA", Name: "Bali", Type: "Province", Parent: "ID-NU"},
+		{Code: "ID-BB", Name: "Kepulauan Bangka Belitung", Type: "Province", Parent: "ID-SM"},
+		{Code: "ID-BE", Name: "Bengkulu", Type: "Province", Parent: "ID-SM"},
+		{Code: "ID-BT", Name: "Banten", Type: "Province", Parent: "ID-JW"},
+		{Code: "ID-GO", Name: "Gorontalo", Type: "Province", Parent: "ID-SL"},
+		{Code: "ID-JA", Name: "Jambi", Type: "Province", Parent: "ID-SM"},
+		{Code: "ID-JB", Name: "Jawa Barat", Type: "Province", Parent: "ID-JW"},
+		{Code: "ID-JI", Name: "Jawa Timur", Type: "Province", Parent: "ID-JW"},
+		{Code: "ID-JK", Name: "Jakarta Raya", Type: "Capital district", Parent: "ID-JW"},
+		{Code: "ID-JT", Name: "Jawa Tengah", Type: "Province", Parent: "ID-JW"},
+		{Code: "ID-JW", Name: "Jawa", Type: "Geographical unit"},
+		{Code: "ID-KA", Name: "Kalimantan", Type: "Geographical unit"},
+		{Code: "ID-KB", Name: "Kalimantan Barat", Type: "Province", Parent: "ID-KA"},
+		{Code: "ID-KI", Name: "Kalimantan Timur", Type: "Province", Parent: "ID-KA"},
+		{Code: "ID-KR", Name: "Kepulauan Riau", Type: "Province", Parent: "ID-SM"},
+		{Code: "ID-KS", Name: "Kalimantan Selatan", Type: "Province", Parent: "ID-KA"},
+		{Code: "ID-KT", Name: "Kalimantan Tengah", Type: "Province", Parent: "ID-KA"},
+		{Code: "ID-KU", Name: "Kalimantan Utara", Type: "Province", Parent: "ID-KA"},
+		{Code: "ID-LA", Name: "Lampung", Type: "Province", Parent: "ID-SM"},
+		{Code: "ID-MA", Name: "Maluku", Type: "Province", Parent: "ID-ML"},
+		{Code: "ID-ML", Name: "Maluku", Type: "Geographical unit"},
+		{Code: "ID-MU", Name: "Maluku Utara", Type: "Province", Parent: "ID-ML"},
+		{Code: "ID-NB", Name: "Nusa Tenggara Barat", Type: "Province", Parent: "ID-NU"},
+		{Code: "ID-NT", Name: "Nusa Tenggara Timur", Type: "Province", Parent: "ID-NU"},
+		{Code: "ID-NU", Name: "Nusa Tenggara", Type: "Geographical unit"},
+		{Code: "ID-PA", Name: "Papua", Type: "Province", Parent: "ID-PP"},
+		{Code: "ID-PB", Name: "Papua Barat", Type: "Province", Parent: "ID-PP"},
+		{Code: "ID-PD", Name: "Papua Barat Daya", Type: "Province", Parent: "ID-PP"},
+		{Code: "ID-PE", Name: "Papua Pegunungan", Type: "Province", Parent: "ID-PP"},
+		{Code: "ID-PP", Name: "Papua", Type: "Geographical unit"},
+		{Code: "ID-PS", Name: "Papua Selatan", Type: "Province", Parent: "ID-PP"},
+		{Code: "ID-PT", Name: "Papua Tengah", Type: "Province", Parent: "ID-PP"},
+		{Code: "ID-RI", Name: "Riau", Type: "Province", Parent: "ID-SM"},
+		{Code: "ID-SA", Name: "Sulawesi Utara", Type: "Province", Parent: "ID-SL"},
+		{Code: "ID-SB", Name: "Sumatera Barat", Type: "Province", Parent: "ID-SM"},
+		{Code: "ID-SG", Name: "Sulawesi Tenggara", Type: "Province", Parent: "ID-SL"},
+		{Code: "ID-SL", Name: "Sulawesi", Type: "Geographical unit"},
+		{Code: "ID-SM", Name: "Sumatera", Type: "Geographical unit"},
+		{Code: "ID-SN", Name: "Sulawesi Selatan", Type: "Province", Parent: "ID-SL"},
+		{Code: "ID-SR", Name: "Sulawesi Barat", Type: "Province", Parent: "ID-SL"},
+		{Code: "ID-SS", Name: "Sumatera Selatan", Type: "Province", Parent: "ID-SM"},
+		{Code: "ID-ST", Name: "Sulawesi Tengah", Type: "Province", Parent: "ID-SL"},
+		{Code: "ID-SU", Name: "Sumatera Utara", Type: "Province", Parent: "ID-SM"},
+		{Code: "ID-YO", Name: "Yogyakarta", Type: "Special region", Parent: "ID-JW"},
+	},
+	"MY": {
+		{Code: "MY-01", Name: "Johor", Type: "State"},
+		{Code: "MY-02", Name: "Kedah", Type: "State"},
+		{Code: "MY-03", Name: "Kelantan", Type: "State"},
+		{Code: "MY-04", Name: "Melaka", Type: "State"},
+		{Code: "MY-05", Name: "Negeri Sembilan", Type: "State"},
+		{Code: "MY-06", Name: "Pahang", Type: "State"},
+		{Code: "MY-07", Name: "Pulau Pinang", Type: "State"},
+		{Code: "MY-08", Name: "Perak", Type: "State"},
+		{Code: "MY-09", Name: "Perlis", Type: "State"},
+		{Code: "MY-10", Name: "Selangor", Type: "State"},
+		{Code: "MY-11", Name: "Terengganu", Type: "State"},
+		{Code: "MY-12", Name: "Sabah", Type: "State"},
+		{Code: "MY-13", Name: "Sarawak", Type: "State"},
+		{Code: "MY-14", Name: "Wilayah Persekutuan Kuala Lumpur", Type: "Federal territory"},
+		{Code: "MY-15", Name: "Wilayah Persekutuan Labuan", Type: "Federal territory"},
+		{Code: "MY-16", Name: "Wilayah Persekutuan Putrajaya", Type: "Federal territory"},
+	},
+	"PH": {
+		{Code: "PH-00", Name: "National Capital Region", Type: "Region"},
+		{Code: "PH-01", Name: "Ilocos (Region I)", Type: "Region"},
+		{Code: "PH-02", Name: "Cagayan Valley (Region II)", Type: "Region"},
+		{Code: "PH-03", Name: "Central Luzon (Region III)", Type: "Region"},
+		{Code: "PH-05", Name: "Bicol (Region V)", Type: "Region"},
+		{Code: "PH-06", Name: "Western Visayas (Region VI)", Type: "Region"},
+		{Code: "PH-07", Name: "Central Visayas (Region VII)", Type: "Region"},
+		{Code: "PH-08", Name: "Eastern Visayas (Region VIII)", Type: "Region"},
+		{Code: "PH-09", Name: "Zamboanga Peninsula (Region IX)", Type: "Region"},
+		{Code: "PH-10", Name: "Northern Mindanao (Region X)", Type: "Region"},
+		{Code: "PH-11", Name: "Davao (Region XI)", Type: "Region"},
+		{Code: "PH-12", Name: "Soccsksargen (Region XII)", Type: "Region"},
+		{Code: "PH-13", Name: "Caraga (Region XIII)", Type: "Region"},
+		{Code: "PH-14", Name: "Autonomous Region in Muslim Mindanao (ARMM)", Type: "Region"},
+		{Code: "PH-15", Name: "Cordillera Administrative Region (CAR)", Type: "Region"},
+		{Code: "PH-40", Name: "Calabarzon (Region IV-A)", Type: "Region"},
+		{Code: "PH-41", Name: "Mimaropa (Region IV-B)", Type: "Region"},
+		{Code: "PH-ABR", Name: "Abra", Type: "Province", Parent: "PH-15"},
+		{Code: "PH-AGN", Name: "Agusan del Norte", Type: "Province", Parent: "PH-13"},
+		{Code: "PH-AGS", Name: "Agusan del Sur", Type: "Province", Parent: "PH-13"},
+		{Code: "PH-AKL", Name: "Aklan", Type: "Province", Parent: "PH-06"},
+		{Code: "PH-ALB", Name: "Albay", Type: "Province", Parent: "PH-05"},
+		{Code: "PH-ANT", Name: "Antique", Type: "Province", Parent: "PH-06"},
+		{Code: "PH-APA", Name: "Apayao", Type: "Province", Parent: "PH-15"},
+		{Code: "PH-AUR", Name: "Aurora", Type: "Province", Parent: "PH-03"},
+		{Code: "PH-BAN", Name: "Bataan", Type: "Province", Parent: "PH-03"},
+		{Code: "PH-BAS", Name: "Basilan", Type: "Province", Parent: "PH-09"},
+		{Code: "PH-BEN", Name: "Benguet", Type: "Province", Parent: "PH-15"},
+		{Code: "PH-BIL", Name: "Biliran", Type: "Province", Parent: "PH-08"},
+		{Code: "PH-BOH", Name: "Bohol", Type: "Province", Parent: "PH-07"},
+		{Code: "PH-BTG", Name: "Batangas", Type: "Province", Parent: "PH-40"},
+		{Code: "PH-BTN", Name: "Batanes", Type: "Province", Parent: "PH-02"},
+		{Code: "PH-BUK", Name: "Bukidnon", Type: "Province", Parent: "PH-10"},
+		{Code: "PH-BUL", Name: "Bulacan", Type: "Province", Parent: "PH-03"},
+		{Code: "PH-CAG", Name: "Cagayan", Type: "Province", Parent: "PH-02"},
+		{Code: "PH-CAM", Name: "Camiguin", Type: "Province", Parent: "PH-10"},
+		{Code: "PH-CAN", Name: "Camarines Norte", Type: "Province", Parent: "PH-05"},
+		{Code: "PH-CAP", Name: "Capiz", Type: "Province", Parent: "PH-06"},
+		{Code: "PH-CAS", Name: "Camarines Sur", Type: "Province", Parent: "PH-05"},
+		{Code: "PH-CAT", Name: "Catanduanes", Type: "Province", Parent: "PH-05"},
+		{Code: "PH-CAV", Name: "Cavite", Type: "Province", Parent: "PH-40"},
+		{Code: "PH-CEB", Name: "Cebu", Type: "Province", Parent: "PH-07"},
+		{Code: "PH-COM", Name: "Davao de Oro", Type: "Province", Parent: "PH-11"},
+		{Code: "PH-DAO", Name: "Davao Oriental", Type: "Province", Parent: "PH-11"},
+		{Code: "PH-DAS", Name: "Davao del Sur", Type: "Province", Parent: "PH-11"},
+		{Code: "PH-DAV", Name: "Davao del Norte", Type: "Province", Parent: "PH-11"},
+		{Code: "PH-DIN", Name: "Dinagat Islands", Type: "Province", Parent: "PH-13"},
+		{Code: "PH-DVO", Name: "Davao Occidental", Type: "Province", Parent: "PH-11"},
+		{Code: "PH-EAS", Name: "Eastern Samar", Type: "Province", Parent: "PH-08"},
+		{Code: "PH-GUI", Name: "Guimaras", Type: "Province", Parent: "PH-06"},
+		{Code: "PH-IFU", Name: "Ifugao", Type: "Province", Parent: "PH-15"},
+		{Code: "PH-ILI", Name: "Iloilo", Type: "Province", Parent: "PH-06"},
+		{Code: "PH-ILN", Name: "Ilocos Norte", Type: "Province", Parent: "PH-01"},
+		{Code: "PH-ILS", Name: "Ilocos Sur", Type: "Province", Parent: "PH-01"},
+		{Code: "PH-ISA", Name: "Isabela", Type: "Province", Parent: "PH-02"},
+		{Code: "PH-KAL", Name: "Kalinga", Type: "Province", Parent: "PH-15"},
+		{Code: "PH-LAG", Name: "Laguna", Type: "Province", Parent: "PH-40"},
+		{Code: "PH-LAN", Name: "Lanao del Norte", Type: "Province", Parent: "PH-12"},
+		{Code: "PH-LAS", Name: "Lanao del Sur", Type: "Province", Parent: "PH-14"},
+		{Code: "PH-LEY", Name: "Leyte", Type: "Province", Parent: "PH-08"},
+		{Code: "PH-LUN", Name: "La Union", Type: "Province", Parent: "PH-01"},
+		{Code: "PH-MAD", Name: "Marinduque", Type: "Province", Parent: "PH-41"},
+		{Code: "PH-MAG", Name: "Maguindanao", Type: "Province", Parent: "PH-14"},
+		{Code: "PH-MAS", Name: "Masbate", Type: "Province", Parent: "PH-05"},
+		{Code: "PH-MDC", Name: "Mindoro Occidental", Type: "Province", Parent: "PH-41"},
+		{Code: "PH-MDR", Name: "Mindoro Oriental", Type: "Province", Parent: "PH-41"},
+		{Code: "PH-MOU", Name: "Mountain Province", Type: "Province", Parent: "PH-15"},
+		{Code: "PH-MSC", Name: "Misamis Occidental", Type: "Province", Parent: "PH-10"},
+		{Code: "PH-MSR", Name: "Misamis Oriental", Type: "Province", Parent: "PH-10"},
+		{Code: "PH-NCO", Name: "Cotabato", Type: "Province", Parent: "PH-12"},
+		{Code: "PH-NEC", Name: "Negros Occidental", Type: "Province", Parent: "PH-06"},
+		{Code: "PH-NER", Name: "Negros Oriental", Type: "Province", Parent: "PH-07"},
+		{Code: "PH-NSA", Name: "Northern Samar", Type: "Province", Parent: "PH-08"},
+		{Code: "PH-NUE", Name: "Nueva Ecija", Type: "Province", Parent: "PH-03"},
+		{Code: "PH-NUV", Name: "Nueva Vizcaya", Type: "Province", Parent: "PH-02"},
+		{Code: "PH-PAM", Name: "Pampanga", Type: "Province", Parent: "PH-03"},
+		{Code: "PH-PAN", Name: "Pangasinan", Type: "Province", Parent: "PH-01"},
+		{Code: "PH-PLW", Name: "Palawan", Type: "Province", Parent: "PH-41"},
+		{Code: "PH-QUE", Name: "Quezon", Type: "Province", Parent: "PH-40"},
+		{Code: "PH-QUI", Name: "Quirino", Type: "Province", Parent: "PH-02"},
+		{Code: "PH-RIZ", Name: "Rizal", Type: "Province", Parent: "PH-40"},
+		{Code: "PH-ROM", Name: "Romblon", Type: "Province", Parent: "PH-41"},
+		{Code: "PH-SAR", Name: "Sarangani", Type: "Province", Parent: "PH-11"},
+		{Code: "PH-SCO", Name: "South Cotabato", Type: "Province", Parent: "PH-11"},
+		{Code: "PH-SIG", Name: "Siquijor", Type: "Province", Parent: "PH-07"},
+		{Code: "PH-SLE", Name: "Southern Leyte", Type: "Province", Parent: "PH-08"},
+		{Code: "PH-SLU", Name: "Sulu", Type: "Province", Parent: "PH-14"},
+		{Code: "PH-SOR", Name: "Sorsogon", Type: "Province", Parent: "PH-05"},
+		{Code: "PH-SUK", Name: "Sultan Kudarat", Type: "Province", Parent: "PH-12"},
+		{Code: "PH-SUN", Name: "Surigao del Norte", Type: "Province", Parent: "PH-13"},
+		{Code: "PH-SUR", Name: "Surigao del Sur", Type: "Province", Parent: "PH-13"},
+		{Code: "PH-TAR", Name: "Tarlac", Type: "Province", Parent: "PH-03"},
+		{Code: "PH-TAW", Name: "Tawi-Tawi", Type: "Province", Parent: "PH-14"},
+		{Code: "PH-WSA", Name: "Samar", Type: "Province", Parent: "PH-08"},
+		{Code: "PH-ZAN", Name: "Zamboanga del Norte", Type: "Province", Parent: "PH-09"},
+		{Code: "PH-ZAS", Name: "Zamboanga del Sur", Type: "Province", Parent: "PH-09"},
+		{Code: "PH-ZMB", Name: "Zambales", Type: "Province", Parent: "PH-03"},
+		{Code: "PH-ZSI", Name: "Zamboanga Sibugay", Type: "Province", Parent: "PH-09"},
+	},
+	"SG": {
+		{Code: "SG-01", Name: "Central Singapore", Type: "District"},
+		{Code: "SG-02", Name: "North East", Type: "District"},
+		{Code: "SG-03", Name: "North West", Type: "District"},
+		{Code: "SG-04", Name: "South East", Type: "District"},
+		{Code: "SG-05", Name: "South West", Type: "District"},
+	},
+	"TH": {
+		{Code: "TH-10", Name: "Krung Thep Maha Nakhon", Type: "Metropolitan administration"},
+		{Code: "TH-11", Name: "Samut Prakan", Type: "Province"},
+		{Code: "TH-12", Name: "Nonthaburi", Type: "Province"},
+		{Code: "TH-13", Name: "Pathum Thani", Type: "Province"},
+		{Code: "TH-14", Name: "Phra Nakhon Si Ayutthaya", Type: "Province"},
+		{Code: "TH-15", Name: "Ang Thong", Type: "Province"},
+		{Code: "TH-16", Name: "Lop Buri", Type: "Province"},
+		{Code: "TH-17", Name: "Sing Buri", Type: "Province"},
+		{Code: "TH-18", Name: "Chai Nat", Type: "Province"},
+		{Code: "TH-19", Name: "Saraburi", Type: "Province"},
+		{Code: "TH-20", Name: "Chon Buri", Type: "Province"},
+		{Code: "TH-21", Name: "Rayong", Type: "Province"},
+		{Code: "TH-22", Name: "Chanthaburi", Type: "Province"},
+		{Code: "TH-23", Name: "Trat", Type: "Province"},
+		{Code: "TH-24", Name: "Chachoengsao", Type: "Province"},
+		{Code: "TH-25", Name: "Prachin Buri", Type: "Province"},
+		{Code: "TH-26", Name: "Nakhon Nayok", Type: "Province"},
+		{Code: "TH-27", Name: "Sa Kaeo", Type: "Province"},
+		{Code: "TH-30", Name: "Nakhon Ratchasima", Type: "Province"},
+		{Code: "TH-31", Name: "Buri Ram", Type: "Province"},
+		{Code: "TH-32", Name: "Surin", Type: "Province"},
+		{Code: "TH-33", Name: "Si Sa Ket", Type: "Province"},
+		{Code: "TH-34", Name: "Ubon Ratchathani", Type: "Province"},
+		{Code: "TH-35", Name: "Yasothon", Type: "Province"},
+		{Code: "TH-36", Name: "Chaiyaphum", Type: "Province"},
+		{Code: "TH-37", Name: "Amnat Charoen", Type: "Province"},
+		{Code: "TH-38", Name: "Bueng Kan", Type: "Province"},
+		{Code: "TH-39", Name: "Nong Bua Lam Phu", Type: "Province"},
+		{Code: "TH-40", Name: "Khon Kaen", Type: "Province"},
+		{Code: "TH-41", Name: "Udon Thani", Type: "Province"},
+		{Code: "TH-42", Name: "Loei", Type: "Province"},
+		{Code: "TH-43", Name: "Nong Khai", Type: "Province"},
+		{Code: "TH-44", Name: "Maha Sarakham", Type: "Province"},
+		{Code: "TH-45", Name: "Roi Et", Type: "Province"},
+		{Code: "TH-46", Name: "Kalasin", Type: "Province"},
+		{Code: "TH-47", Name: "Sakon Nakhon", Type: "Province"},
+		{Code: "TH-48", Name: "Nakhon Phanom", Type: "Province"},
+		{Code: "TH-49", Name: "Mukdahan", Type: "Province"},
+		{Code: "TH-50", Name: "Chiang Mai", Type: "Province"},
+		{Code: "TH-51", Name: "Lamphun", Type: "Province"},
+		{Code: "TH-52", Name: "Lampang", Type: "Province"},
+		{Code: "TH-53", Name: "Uttaradit", Type: "Province"},
+		{Code: "TH-54", Name: "Phrae", Type: "Province"},
+		{Code: "TH-55", Name: "Nan", Type: "Province"},
+		{Code: "TH-56", Name: "Phayao", Type: "Province"},
+		{Code: "TH-57", Name: "Chiang Rai", Type: "Province"},
+		{Code: "TH-58", Name: "Mae Hong Son", Type: "Province"},
+		{Code: "TH-60", Name: "Nakhon Sawan", Type: "Province"},
+		{Code: "TH-61", Name: "Uthai Thani", Type: "Province"},
+		{Code: "TH-62", Name: "Kamphaeng Phet", Type: "Province"},
+		{Code: "TH-63", Name: "Tak", Type: "Province"},
+		{Code: "TH-64", Name: "Sukhothai", Type: "Province"},
+		{Code: "TH-65", Name: "Phitsanulok", Type: "Province"},
+		{Code: "TH-66", Name: "Phichit", Type: "Province"},
+		{Code: "TH-67", Name: "Phetchabun", Type: "Province"},
+		{Code: "TH-70", Name: "Ratchaburi", Type: "Province"},
+		{Code: "TH-71", Name: "Kanchanaburi", Type: "Province"},
+		{Code: "TH-72", Name: "Suphan Buri", Type: "Province"},
+		{Code: "TH-73", Name: "Nakhon Pathom", Type: "Province"},
+		{Code: "TH-74", Name: "Samut Sakhon", Type: "Province"},
+		{Code: "TH-75", Name: "Samut Songkhram", Type: "Province"},
+		{Code: "TH-76", Name: "Phetchaburi", Type: "Province"},
+		{Code: "TH-77", Name: "Prachuap Khiri Khan", Type: "Province"},
+		{Code: "TH-80", Name: "Nakhon Si Thammarat", Type: "Province"},
+		{Code: "TH-81", Name: "Krabi", Type: "Province"},
+		{Code: "TH-82", Name: "Phangnga", Type: "Province"},
+		{Code: "TH-83", Name: "Phuket", Type: "Province"},
+		{Code: "TH-84", Name: "Surat Thani", Type: "Province"},
+		{Code: "TH-85", Name: "Ranong", Type: "Province"},
+		{Code: "TH-86", Name: "Chumphon", Type: "Province"},
+		{Code: "TH-90", Name: "Songkhla", Type: "Province"},
+		{Code: "TH-91", Name: "Satun", Type: "Province"},
+		{Code: "TH-92", Name: "Trang", Type: "Province"},
+		{Code: "TH-93", Name: "Phatthalung", Type: "Province"},
+		{Code: "TH-94", Name: "Pattani", Type: "Province"},
+		{Code: "TH-95", Name: "Yala", Type: "Province"},
+		{Code: "TH-96", Name: "Narathiwat", Type: "Province"},
+		{Code: "TH-S", Name: "Phatthaya", Type: "Special administrative city"},
+	},
+	"VN": {
+		{Code: "VN-01", Name: "Lai Châu", Type: "Province"},
+		{Code: "VN-02", Name: "Lào Cai", Type: "Province"},
+		{Code: "VN-03", Name: "Hà Giang", Type: "Province"},
+		{Code: "VN-04", Name: "Cao Bằng", Type: "Province"},
+		{Code: "VN-05", Name: "Sơn La", Type: "Province"},
+		{Code: "VN-06", Name: "Yên Bái", Type: "Province"},
+		{Code: "VN-07", Name: "Tuyên Quang", Type: "Province"},
+		{Code: "VN-09", Name: "Lạng Sơn", Type: "Province"},
+		{Code: "VN-13", Name: "Quảng Ninh", Type: "Province"},
+		{Code: "VN-14", Name: "Hòa Bình", Type: "Province"},
+		{Code: "VN-18", Name: "Ninh Bình", Type: "Province"},
+		{Code: "VN-20", Name: "Thái Bình", Type: "Province"},
+		{Code: "VN-21", Name: "Thanh Hóa", Type: "Province"},
+		{Code: "VN-22", Name: "Nghệ An", Type: "Province"},
+		{Code: "VN-23", Name: "Hà Tĩnh", Type: "Province"},
+		{Code: "VN-24", Name: "Quảng Bình", Type: "Province"},
+		{Code: "VN-25", Name: "Quảng Trị", Type: "Province"},
+		{Code: "VN-26", Name: "Thừa Thiên-Huế", Type: "Province"},
+		{Code: "VN-27", Name: "Quảng Nam", Type: "Province"},
+		{Code: "VN-28", Name: "Kon Tum", Type: "Province"},
+		{Code: "VN-29", Name: "Quảng Ngãi", Type: "Province"},
+		{Code: "VN-30", Name: "Gia Lai", Type: "Province"},
+		{Code: "VN-31", Name: "Bình Định", Type: "Province"},
+		{Code: "VN-32", Name: "Phú Yên", Type: "Province"},
+		{Code: "VN-33", Name: "Đắk Lắk", Type: "Province"},
+		{Code: "VN-34", Name: "Khánh Hòa", Type: "Province"},
+		{Code: "VN-35", Name: "Lâm Đồng", Type: "Province"},
+		{Code: "VN-36", Name: "Ninh Thuận", Type: "Province"},
+		{Code: "VN-37", Name: "Tây Ninh", Type: "Province"},
+		{Code: "VN-39", Name: "Đồng Nai", Type: "Province"},
+		{Code: "VN-40", Name: "Bình Thuận", Type: "Province"},
+		{Code: "VN-41", Name: "Long An", Type: "Province"},
+		{Code: "VN-43", Name: "Bà Rịa - Vũng Tàu", Type: "Province"},
+		{Code: "VN-44", Name: "An Giang", Type: "Province"},
+		{Code: "VN-45", Name: "Đồng Tháp", Type: "Province"},
+		{Code: "VN-46", Name: "Tiền Giang", Type: "Province"},
+		{Code: "VN-47", Name: "Kiến Giang", Type: "Province"},
+		{Code: "VN-49", Name: "Vĩnh Long", Type: "Province"},
+		{Code: "VN-50", Name: "Bến Tre", Type: "Province"},
+		{Code: "VN-51", Name: "Trà Vinh", Type: "Province"},
+		{Code: "VN-52", Name: "Sóc Trăng", Type: "Province"},
+		{Code: "VN-53", Name: "Bắc Kạn", Type: "Province"},
+		{Code: "VN-54", Name: "Bắc Giang", Type: "Province"},
+		{Code: "VN-55", Name: "Bạc Liêu", Type: "Province"},
+		{Code: "VN-56", Name: "Bắc Ninh", Type: "Province"},
+		{Code: "VN-57", Name: "Bình Dương", Type: "Province"},
+		{Code: "VN-58", Name: "Bình Phước", Type: "Province"},
+		{Code: "VN-59", Name: "Cà Mau", Type: "Province"},
+		{Code: "VN-61", Name: "Hải Dương", Type: "Province"},
+		{Code: "VN-63", Name: "Hà Nam", Type: "Province"},
+		{Code: "VN-66", Name: "Hưng Yên", Type: "Province"},
+		{Code: "VN-67", Name: "Nam Định", Type: "Province"},
+		{Code: "VN-68", Name: "Phú Thọ", Type: "Province"},
+		{Code: "VN-69", Name: "Thái Nguyên", Type: "Province"},
+		{Code: "VN-70", Name: "Vĩnh Phúc", Type: "Province"},
+		{Code: "VN-71", Name: "Điện Biên", Type: "Province"},
+		{Code: "VN-72", Name: "Đắk Nông", Type: "Province"},
+		{Code: "VN-73", Name: "Hậu Giang", Type: "Province"},
+		{Code: "VN-CT", Name: "Cần Thơ", Type: "Municipality"},
+		{Code: "VN-DN", Name: "Đà Nẵng", Type: "Municipality"},
+		{Code: "VN-HN", Name: "Hà Nội", Type: "Municipality"},
+		{Code: "VN-HP", Name: "Hải Phòng", Type: "Municipality"},
+		{Code: "VN-SG", Name: "Hồ Chí Minh", Type: "Municipality"},
+	},
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	countrycodes "github.com/willy182/goshare/country_codes"
+)
+
+const (
+	// prefixDepth longest prefix derived from a national number pattern
+	prefixDepth = 4
+	// internationalPrefixDepth longest prefix derived from an international prefix pattern
+	internationalPrefixDepth = 5
+)
+
+// phoneNumberMetadata data structure of libphonenumber PhoneNumberMetadata.xml
+type phoneNumberMetadata struct {
+	Territories []territory `xml:"territories>territory"`
+}
+
+// territory data structure of a libphonenumber territory
+type territory struct {
+	ID                           string         `xml:"id,attr"`
+	CountryCode                  string         `xml:"countryCode,attr"`
+	NationalPrefix               string         `xml:"nationalPrefix,attr"`
+	InternationalPrefix          string         `xml:"internationalPrefix,attr"`
+	PreferredInternationalPrefix string         `xml:"preferredInternationalPrefix,attr"`
+	NationalPrefixFormattingRule string         `xml:"nationalPrefixFormattingRule,attr"`
+	MainCountryForCode           bool           `xml:"mainCountryForCode,attr"`
+	NumberFormats                []numberFormat `xml:"availableFormats>numberFormat"`
+	FixedLine                    *numberDesc    `xml:"fixedLine"`
+	Mobile                       *numberDesc    `xml:"mobile"`
+	TollFree                     *numberDesc    `xml:"tollFree"`
+	PremiumRate                  *numberDesc    `xml:"premiumRate"`
+	SharedCost                   *numberDesc    `xml:"sharedCost"`
+	VoIP                         *numberDesc    `xml:"voip"`
+}
+
+// numberFormat data structure of a libphonenumber number format
+type numberFormat struct {
+	Pattern                      string   `xml:"pattern,attr"`
+	NationalPrefixFormattingRule string   `xml:"nationalPrefixFormattingRule,attr"`
+	LeadingDigits                []string `xml:"leadingDigits"`
+	Format                       string   `xml:"format"`
+	IntlFormat                   []string `xml:"intlFormat"`
+}
+
+// numberDesc data structure of a libphonenumber number type description
+type numberDesc struct {
+	PossibleLengths struct {
+		National string `xml:"national,attr"`
+	} `xml:"possibleLengths"`
+	NationalNumberPattern string `xml:"nationalNumberPattern"`
+}
+
+// convert function for reading PhoneNumberMetadata.xml into the phone metadata of base countries, whose
+// order is kept. Base countries missing from the file have no phone metadata and territories missing
+// from base are returned as skipped
+func convert(r io.Reader, base []countrycodes.Country) ([]countrycodes.Country, []string, error) {
+	var metadata phoneNumberMetadata
+	if err := xml.NewDecoder(r).Decode(&metadata); err != nil {
+		return nil, nil, err
+	}
+
+	territories := make(map[string]territory, len(metadata.Territories))
+	mainFormats := make(map[string][]numberFormat)
+	mainRules := make(map[string]string)
+	for _, t := range metadata.Territories {
+		territories[t.ID] = t
+		if t.MainCountryForCode || len(mainFormats[t.CountryCode]) == 0 {
+			mainFormats[t.CountryCode] = t.NumberFormats
+			mainRules[t.CountryCode] = t.NationalPrefixFormattingRule
+		}
+	}
+
+	var (
+		countries = make([]countrycodes.Country, 0, len(base))
+		known     = make(map[string]bool, len(base))
+	)
+	for _, country := range base {
+		known[country.Alpha2] = true
+		// libphonenumber has no vanity number data, the flag of base is kept
+		vanityNumbers := country.Phone != nil && country.Phone.VanityNumbers
+		country.Phone = nil
+		t, ok := territories[country.Alpha2]
+		if !ok {
+			countries = append(countries, country)
+			continue
+		}
+
+		phone, err := convertTerritory(t)
+		if err != nil {
+			return nil, nil, fmt.Errorf("territory %s: %v", t.ID, err)
+		}
+		// territories sharing a calling code use the formats of the main country when they have none
+		if len(t.NumberFormats) == 0 {
+			rule := t.NationalPrefixFormattingRule
+			if rule == "" {
+				rule = mainRules[t.CountryCode]
+			}
+			phone.NumberFormats = convertFormats(mainFormats[t.CountryCode], rule)
+		}
+		phone.VanityNumbers = vanityNumbers
+		country.Phone = &phone
+		countries = append(countries, country)
+	}
+
+	var skipped []string
+	for _, t := range metadata.Territories {
+		if !known[t.ID] {
+			skipped = append(skipped, t.ID)
+		}
+	}
+	sort.Strings(skipped)
+
+	return countries, skipped, nil
+}
+
+// convertTerritory function for getting the phone metadata of territory
+func convertTerritory(t territory) (countrycodes.PhoneMetadata, error) {
+	phone := countrycodes.PhoneMetadata{
+		CountryCode:        t.CountryCode,
+		NationalPrefix:     t.NationalPrefix,
+		MainCountryForCode: t.MainCountryForCode,
+		MobileBeginWith:    []string{},
+		NumberFormats:      convertFormats(t.NumberFormats, t.NationalPrefixFormattingRule),
+	}
+
+	internationalPrefixes, err := convertInternationalPrefixes(t)
+	if err != nil {
+		return phone, err
+	}
+	phone.InternationalPrefixes = internationalPrefixes
+
+	lengths := make(map[int]bool)
+	descs := []struct {
+		desc     *numberDesc
+		prefixes *[]string
+	}{
+		{t.Mobile, &phone.MobileBeginWith},
+		{t.FixedLine, &phone.FixedLineBeginWith},
+		{t.TollFree, &phone.TollFreeBeginWith},
+		{t.PremiumRate, &phone.PremiumRateBeginWith},
+		{t.SharedCost, &phone.SharedCostBeginWith},
+		{t.VoIP, &phone.VoIPBeginWith},
+	}
+	for _, d := range descs {
+		if d.desc == nil || d.desc.NationalNumberPattern == "" {
+			continue
+		}
+
+		pattern := strings.Join(strings.Fields(d.desc.NationalNumberPattern), "")
+		prefixes, err := patternPrefixes(pattern, prefixDepth)
+		if err != nil {
+			return phone, err
+		}
+		*d.prefixes = prefixes
+
+		typeLengths, err := parseLengths(d.desc.PossibleLengths.National)
+		if err != nil {
+			return phone, err
+		}
+		for _, l := range typeLengths {
+			lengths[l] = true
+		}
+	}
+
+	for l := range lengths {
+		phone.PhoneNumberLengths = append(phone.PhoneNumberLengths, l)
+	}
+	sort.Ints(phone.PhoneNumberLengths)
+
+	return phone, nil
+}
+
+// convertInternationalPrefixes function for getting the international prefixes matched by the territory
+// international prefix pattern, the preferred prefix first. The wait for dial tone ~ is dropped
+func convertInternationalPrefixes(t territory) ([]string, error) {
+	var prefixes []string
+	if preferred := strings.Replace(t.PreferredInternationalPrefix, "~", "", -1); preferred != "" {
+		prefixes = append(prefixes, preferred)
+	}
+	if t.InternationalPrefix == "" {
+		return prefixes, nil
+	}
+
+	matched, err := patternPrefixes(t.InternationalPrefix, internationalPrefixDepth)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range matched {
+		if p != "" && (len(prefixes) == 0 || p != prefixes[0]) {
+			prefixes = append(prefixes, p)
+		}
+	}
+	return prefixes, nil
+}
+
+// convertFormats function for mapping number formats, rule is the territory national prefix formatting rule
+func convertFormats(formats []numberFormat, rule string) []countrycodes.NumberFormat {
+	var converted []countrycodes.NumberFormat
+	for _, f := range formats {
+		formatRule := f.NationalPrefixFormattingRule
+		if formatRule == "" {
+			formatRule = rule
+		}
+
+		national := f.Format
+		if formatRule != "" {
+			// the rule replaces the first group, e.g. $NP$FG on $1-$2 gives $NP$1-$2
+			firstGroup := strings.Replace(formatRule, "$FG", "$1", 1)
+			firstGroup = strings.TrimSpace(strings.Replace(firstGroup, "$CC", "", -1))
+			national = strings.Replace(national, "$1", firstGroup, 1)
+		}
+
+		intl := f.Format
+		if len(f.IntlFormat) > 0 && f.IntlFormat[0] != "NA" {
+			intl = f.IntlFormat[0]
+		}
+
+		c := countrycodes.NumberFormat{
+			Pattern: `^(?:` + f.Pattern + `)$`,
+			Format:  national,
+		}
+		if intl != national {
+			c.InternationalFormat = intl
+		}
+		// the last leading digits pattern is the most precise one
+		if len(f.LeadingDigits) > 0 {
+			c.LeadingDigits = strings.Join(strings.Fields(f.LeadingDigits[len(f.LeadingDigits)-1]), "")
+		}
+		if _, err := regexp.Compile(c.Pattern); err != nil {
+			continue
+		}
+		converted = append(converted, c)
+	}
+	return converted
+}
+
+// parseLengths function for parsing possible lengths such as "7,8" or "[7-9],11"
+func parseLengths(lengths string) ([]int, error) {
+	var parsed []int
+	for _, part := range strings.Split(lengths, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" || part == "-1" {
+			continue
+		}
+
+		if strings.HasPrefix(part, "[") && strings.HasSuffix(part, "]") {
+			bounds := strings.SplitN(part[1:len(part)-1], "-", 2)
+			if len(bounds) != 2 {
+				return nil, fmt.Errorf("invalid length range %q", part)
+			}
+			from, err := strconv.Atoi(bounds[0])
+			if err != nil {
+				return nil, err
+			}
+			to, err := strconv.Atoi(bounds[1])
+			if err != nil {
+				return nil, err
+			}
+			for l := from; l <= to; l++ {
+				parsed = append(parsed, l)
+			}
+			continue
+		}
+
+		l, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, l)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,58 @@
+// Command importlibphonenumber converts libphonenumber PhoneNumberMetadata.xml into
+// the metadata file of package countrycodes.
+//
+// Number type patterns are turned into leading digit prefixes, possible lengths of every
+// type are merged into the country lengths, and the ISO 3166 country data comes from the
+// metadata currently embedded in the package.
+//
+// Usage:
+//
+//	go run ./cmd/importlibphonenumber -i PhoneNumberMetadata.xml -o metadata.json
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	countrycodes "github.com/willy182/goshare/country_codes"
+)
+
+func main() {
+	input := flag.String("i", "PhoneNumberMetadata.xml", "libphonenumber metadata file")
+	output := flag.String("o", "", "output file, standard output when empty")
+	flag.Parse()
+
+	f, err := os.Open(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	countries, skipped, err := convert(f, countrycodes.GetCountries())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(skipped) > 0 {
+		log.Printf("skipped territories without ISO 3166 country: %s", strings.Join(skipped, ", "))
+	}
+
+	var buf bytes.Buffer
+	if err := countrycodes.WriteMetadata(&buf, countries); err != nil {
+		log.Fatal(err)
+	}
+	if _, err := countrycodes.ReadMetadata(bytes.NewReader(buf.Bytes())); err != nil {
+		log.Fatal(err)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(buf.Bytes())
+		return
+	}
+	if err := ioutil.WriteFile(*output, buf.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	countrycodes "github.com/willy182/goshare/country_codes"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestConvertGolden(t *testing.T) {
+	f, err := os.Open("testdata/PhoneNumberMetadata.xml")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	var base []countrycodes.Country
+	for _, alpha2 := range []string{"US", "AI", "GB", "ID", "SG"} {
+		country, ok := countrycodes.LookupCountry(alpha2)
+		assert.True(t, ok)
+		base = append(base, country)
+	}
+
+	countries, skipped, err := convert(f, base)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"XK"}, skipped)
+	// SG is missing from the file
+	assert.Nil(t, countries[4].Phone)
+	// the vanity number flag of base is kept
+	assert.True(t, countries[0].Phone.VanityNumbers)
+	assert.False(t, countries[3].Phone.VanityNumbers)
+
+	var buf bytes.Buffer
+	assert.NoError(t, countrycodes.WriteMetadata(&buf, countries))
+
+	if *update {
+		assert.NoError(t, ioutil.WriteFile("testdata/metadata.golden.json", buf.Bytes(), 0644))
+	}
+	golden, err := ioutil.ReadFile("testdata/metadata.golden.json")
+	assert.NoError(t, err)
+	assert.Equal(t, string(golden), buf.String())
+
+	// the converted metadata drives parsing and formatting
+	assert.NoError(t, countrycodes.LoadMetadata(bytes.NewReader(golden)))
+	defer countrycodes.ResetMetadata()
+
+	testCases := []struct {
+		name, number, country, international, national string
+		numberType                                     countrycodes.NumberType
+	}{
+		{name: "Testcase #1: Indonesia mobile", number: "0812 3456 7890", country: "ID",
+			international: "+62 812-3456-7890", national: "0812-3456-7890", numberType: countrycodes.NumberTypeMobile},
+		{name: "Testcase #2: Jakarta landline", number: "021 8350 1234", country: "ID",
+			international: "+62 21 83501234", national: "(021) 83501234", numberType: countrycodes.NumberTypeFixedLine},
+		{name: "Testcase #3: Anguilla mobile", number: "+1 264 235 1234",
+			international: "+1 264-235-1234", national: "(264) 235-1234", numberType: countrycodes.NumberTypeMobile},
+		{name: "Testcase #4: United States toll free", number: "+1 800 234 5678",
+			international: "+1 800-234-5678", national: "(800) 234-5678", numberType: countrycodes.NumberTypeTollFree},
+		{name: "Testcase #5: United Kingdom mobile", number: "07400 123456", country: "GB",
+			international: "+44 7400 123456", national: "07400 123456", numberType: countrycodes.NumberTypeMobile},
+		{name: "Testcase #6: United Kingdom VoIP", number: "+44 56 1234 5678",
+			international: "+44 56 1234 5678", national: "056 1234 5678", numberType: countrycodes.NumberTypeVoIP},
+	}
+
+	all := countrycodes.AllowTypes(countrycodes.NumberTypeMobile, countrycodes.NumberTypeFixedLine,
+		countrycodes.NumberTypeTollFree, countrycodes.NumberTypePremiumRate,
+		countrycodes.NumberTypeSharedCost, countrycodes.NumberTypeVoIP)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := countrycodes.ParsePhone(tc.number, tc.country, all)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.numberType, p.Type)
+			assert.Equal(t, tc.international, p.Format(countrycodes.International))
+			assert.Equal(t, tc.national, p.Format(countrycodes.National))
+		})
+	}
+}
+
+func TestParseLengths(t *testing.T) {
+	lengths, err := parseLengths("7,[9-11],13")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{7, 9, 10, 11, 13}, lengths)
+
+	_, err = parseLengths("[9]")
+	assert.Error(t, err)
+	_, err = parseLengths("x")
+	assert.Error(t, err)
+}
+
+func TestPatternPrefixes(t *testing.T) {
+	testCases := []struct {
+		name, pattern string
+		expected      []string
+	}{
+		{name: "Testcase #1: literal prefixes", pattern: `264(?:292|4(?:6[12]|9[78]))\d{4}`, expected: []string{"2642", "2644"}},
+		{name: "Testcase #2: character class", pattern: `8[1-35-9]\d{7,10}`, expected: []string{"81", "82", "83", "85", "86", "87", "88", "89"}},
+		{name: "Testcase #3: any number", pattern: `\d{7}`, expected: []string{""}},
+		{name: "Testcase #4: number shorter than depth", pattern: `1\d`, expected: []string{"1"}},
+		{name: "Testcase #5: last digit collapsed", pattern: `20[1-35-9][2-9]\d{6}`, expected: []string{"201", "202", "203", "205", "206", "207", "208", "209"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prefixes, err := patternPrefixes(tc.pattern, prefixDepth)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, prefixes)
+		})
+	}
+
+	_, err := patternPrefixes(`(`, prefixDepth)
+	assert.Error(t, err)
+}
+
+func TestConvertInternationalPrefixes(t *testing.T) {
+	testCases := []struct {
+		name      string
+		territory territory
+		expected  []string
+	}{
+		{name: "Testcase #1: single prefix", territory: territory{InternationalPrefix: "00"}, expected: []string{"00"}},
+		{name: "Testcase #2: alternatives", territory: territory{InternationalPrefix: "00[89]|01017"},
+			expected: []string{"008", "009", "01017"}},
+		{name: "Testcase #3: preferred prefix with wait for dial tone", territory: territory{InternationalPrefix: "810",
+			PreferredInternationalPrefix: "8~10"}, expected: []string{"810"}},
+		{name: "Testcase #4: no prefix", territory: territory{}, expected: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prefixes, err := convertInternationalPrefixes(tc.territory)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, prefixes)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// lastDigitCollapse number of digits allowed at the last expanded position for dropping that position
+const lastDigitCollapse = 8
+
+// prefixExpander data structure for turning a national number pattern into leading digit prefixes
+// by walking the pattern automaton digit by digit
+type prefixExpander struct {
+	prog *syntax.Prog
+	live []bool
+	memo map[string][]string
+}
+
+// patternPrefixes function for getting the shortest digit prefixes, at most maxDepth digits long,
+// covering every number matched by pattern. Prefixes are over approximated when the pattern
+// still branches after maxDepth digits, and []string{""} means any number
+func patternPrefixes(pattern string, maxDepth int) ([]string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return nil, err
+	}
+
+	e := &prefixExpander{prog: prog, memo: make(map[string][]string)}
+	e.markLive()
+
+	start := e.closure([]uint32{uint32(prog.Start)})
+	return e.expand(start, maxDepth), nil
+}
+
+// markLive method for flagging instructions from which a match is reachable by reading digits
+func (e *prefixExpander) markLive() {
+	e.live = make([]bool, len(e.prog.Inst))
+	for changed := true; changed; {
+		changed = false
+		for k, inst := range e.prog.Inst {
+			if e.live[k] {
+				continue
+			}
+			var live bool
+			switch inst.Op {
+			case syntax.InstMatch:
+				live = true
+			case syntax.InstAlt, syntax.InstAltMatch:
+				live = e.live[inst.Out] || e.live[inst.Arg]
+			case syntax.InstCapture, syntax.InstNop, syntax.InstEmptyWidth:
+				live = e.live[inst.Out]
+			case syntax.InstRune, syntax.InstRune1, syntax.InstRuneAny, syntax.InstRuneAnyNotNL:
+				live = e.live[inst.Out] && matchesDigit(&inst)
+			}
+			if live {
+				e.live[k] = true
+				changed = true
+			}
+		}
+	}
+}
+
+// closure method for following empty transitions, keeping live digit reading and match instructions
+func (e *prefixExpander) closure(pcs []uint32) []uint32 {
+	seen := make(map[uint32]bool)
+	var states []uint32
+	stack := append([]uint32(nil), pcs...)
+	for len(stack) > 0 {
+		pc := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[pc] || !e.live[pc] {
+			continue
+		}
+		seen[pc] = true
+
+		inst := &e.prog.Inst[pc]
+		switch inst.Op {
+		case syntax.InstAlt, syntax.InstAltMatch:
+			stack = append(stack, inst.Out, inst.Arg)
+		case syntax.InstCapture, syntax.InstNop, syntax.InstEmptyWidth:
+			stack = append(stack, inst.Out)
+		default:
+			states = append(states, pc)
+		}
+	}
+	sort.Slice(states, func(a, b int) bool { return states[a] < states[b] })
+	return states
+}
+
+// step method for getting the states reached from states by reading digit
+func (e *prefixExpander) step(states []uint32, digit rune) []uint32 {
+	var next []uint32
+	for _, pc := range states {
+		inst := &e.prog.Inst[pc]
+		if inst.Op != syntax.InstMatch && inst.MatchRune(digit) {
+			next = append(next, inst.Out)
+		}
+	}
+	return e.closure(next)
+}
+
+// expand method for getting the prefixes of every number read from states, relative to states
+func (e *prefixExpander) expand(states []uint32, depth int) []string {
+	if len(states) == 0 {
+		return nil
+	}
+	if depth == 0 || e.matches(states) {
+		return []string{""}
+	}
+
+	key := stateKey(states, depth)
+	if prefixes, ok := e.memo[key]; ok {
+		return prefixes
+	}
+
+	var (
+		prefixes []string
+		fulls    int
+	)
+	for d := '0'; d <= '9'; d++ {
+		children := e.expand(e.step(states, d), depth-1)
+		if len(children) == 1 && children[0] == "" {
+			fulls++
+		}
+		for _, child := range children {
+			prefixes = append(prefixes, string(d)+child)
+		}
+	}
+	// the last digit is dropped when nearly every digit follows, e.g. NANP exchange codes [2-9]
+	if fulls == 10 || (depth == 1 && fulls >= lastDigitCollapse) {
+		prefixes = []string{""}
+	}
+
+	e.memo[key] = prefixes
+	return prefixes
+}
+
+// matches method for checking whether the digits read so far are a whole number
+func (e *prefixExpander) matches(states []uint32) bool {
+	for _, pc := range states {
+		if e.prog.Inst[pc].Op == syntax.InstMatch {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesDigit(inst *syntax.Inst) bool {
+	for d := '0'; d <= '9'; d++ {
+		if inst.MatchRune(d) {
+			return true
+		}
+	}
+	return false
+}
+
+func stateKey(states []uint32, depth int) string {
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(depth))
+	for _, pc := range states {
+		b.WriteByte(',')
+		b.WriteString(strconv.Itoa(int(pc)))
+	}
+	return b.String()
+}
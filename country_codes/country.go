@@ -0,0 +1,121 @@
+package countrycodes
+
+import "strings"
+
+// Country data structure of an ISO 3166-1 country, Phone is nil for a country without phone metadata
+type Country struct {
+	Alpha2       string
+	Alpha3       string
+	Numeric      string
+	Name         string
+	OfficialName string
+	CommonName   string
+	// AlternativeNames other names the country is looked up by, such as former names
+	AlternativeNames []string
+	// LocalizedNames names by base language tag, e.g. id, ms or th, see LocalizedName
+	LocalizedNames map[string]string
+	// Region and SubRegion of the UN M49 geoscheme, e.g. Asia and South-eastern Asia
+	Region    string
+	SubRegion string
+	// Currencies ISO 4217 codes of the currencies in use, the main one first
+	Currencies []string
+	TLD        string
+	Capital    string
+	// Subdivisions ISO 3166-2 subdivisions, only known for some countries
+	Subdivisions []Subdivision
+	Phone        *PhoneMetadata
+}
+
+// Subdivision data structure of an ISO 3166-2 subdivision, Parent is the code of
+// the subdivision containing it, e.g. the province of a regency
+type Subdivision struct {
+	Code   string
+	Name   string
+	Type   string
+	Parent string
+}
+
+// ISO3166 method for getting the phone dialing data of the country, false when it has none
+func (c Country) ISO3166() (ISO3166, bool) {
+	if c.Phone == nil {
+		return ISO3166{}, false
+	}
+	return ISO3166{
+		Alpha2:        c.Alpha2,
+		Alpha3:        c.Alpha3,
+		CountryName:   c.Name,
+		PhoneMetadata: *c.Phone,
+	}, true
+}
+
+// Subdivision method for getting a subdivision of the country by its code, e.g. ID-JK
+func (c Country) Subdivision(code string) (Subdivision, bool) {
+	code = strings.ToUpper(code)
+	for _, s := range c.Subdivisions {
+		if s.Code == code {
+			return s, true
+		}
+	}
+	return Subdivision{}, false
+}
+
+// GetCountries function for getting a copy of every country in metadata order,
+// countries with phone metadata come first in GetISO3166 order
+func GetCountries() []Country {
+	all := getRegistry().all
+	countries := make([]Country, len(all))
+	for k, c := range all {
+		countries[k] = c.clone()
+	}
+	return countries
+}
+
+// LookupCountry function for getting a country by alpha2, alpha3, numeric code or any of its names
+func LookupCountry(key string) (Country, bool) {
+	reg := getRegistry()
+	k, ok := reg.countryIndex(key)
+	if !ok {
+		return Country{}, false
+	}
+	return reg.all[k].clone(), true
+}
+
+// clone method for getting a copy of the country sharing no slice, map or phone metadata with c
+func (c Country) clone() Country {
+	c.AlternativeNames = cloneStrings(c.AlternativeNames)
+	if c.LocalizedNames != nil {
+		names := make(map[string]string, len(c.LocalizedNames))
+		for tag, name := range c.LocalizedNames {
+			names[tag] = name
+		}
+		c.LocalizedNames = names
+	}
+	c.Currencies = cloneStrings(c.Currencies)
+	if c.Subdivisions != nil {
+		c.Subdivisions = append([]Subdivision{}, c.Subdivisions...)
+	}
+	if c.Phone != nil {
+		phone := c.Phone.clone()
+		c.Phone = &phone
+	}
+	return c
+}
+
+// LookupSubdivision function for getting a subdivision by its ISO 3166-2 code, e.g. MY-14
+func LookupSubdivision(code string) (Subdivision, bool) {
+	alpha2 := strings.SplitN(code, "-", 2)[0]
+	if len(alpha2) != 2 {
+		return Subdivision{}, false
+	}
+	country, ok := LookupCountry(alpha2)
+	if !ok {
+		return Subdivision{}, false
+	}
+	return country.Subdivision(code)
+}
+
+// countryNames function for getting every name the country is looked up by
+func countryNames(c Country) []string {
+	names := []string{c.Name, c.OfficialName, c.CommonName}
+	return append(names, c.AlternativeNames...)
+}
@@ -0,0 +1,103 @@
+package countrycodes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupCountry(t *testing.T) {
+	testCases := []struct {
+		name, key, expected string
+	}{
+		{name: "Testcase #1: alpha2", key: "id", expected: "ID"},
+		{name: "Testcase #2: alpha3", key: "MYS", expected: "MY"},
+		{name: "Testcase #3: numeric", key: "702", expected: "SG"},
+		{name: "Testcase #4: numeric without leading zeros", key: "8", expected: "AL"},
+		{name: "Testcase #5: short name", key: "viet nam", expected: "VN"},
+		{name: "Testcase #6: official name", key: "Kingdom of Thailand", expected: "TH"},
+		{name: "Testcase #7: common name", key: "Vietnam", expected: "VN"},
+		{name: "Testcase #8: alternative name", key: "Turkey", expected: "TR"},
+		{name: "Testcase #9: country without phone metadata", key: "ATA", expected: "AQ"},
+		{name: "Testcase #10: unknown", key: "Atlantis", expected: ""},
+		{name: "Testcase #11: unknown numeric", key: "999", expected: ""},
+		{name: "Testcase #12: empty", key: "", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			country, ok := LookupCountry(tc.key)
+			assert.Equal(t, tc.expected != "", ok)
+			assert.Equal(t, tc.expected, country.Alpha2)
+		})
+	}
+}
+
+func TestCountry(t *testing.T) {
+	id, ok := LookupCountry("ID")
+	assert.True(t, ok)
+	assert.Equal(t, "IDN", id.Alpha3)
+	assert.Equal(t, "360", id.Numeric)
+	assert.Equal(t, "Republic of Indonesia", id.OfficialName)
+	assert.Equal(t, "Asia", id.Region)
+	assert.Equal(t, "South-eastern Asia", id.SubRegion)
+	assert.Equal(t, []string{"IDR"}, id.Currencies)
+	assert.Equal(t, ".id", id.TLD)
+	assert.Equal(t, "Jakarta", id.Capital)
+
+	// the phone dialing data hangs off the country
+	iso3166, ok := id.ISO3166()
+	assert.True(t, ok)
+	assert.Equal(t, "62", iso3166.CountryCode)
+	assert.Equal(t, "Indonesia", iso3166.CountryName)
+
+	aq, ok := LookupCountry("AQ")
+	assert.True(t, ok)
+	assert.Nil(t, aq.Phone)
+	_, ok = aq.ISO3166()
+	assert.False(t, ok)
+	assert.Equal(t, "", Parse("+672 1 23456", "AQ"))
+
+	countries := GetCountries()
+	assert.Len(t, countries, 249)
+	assert.Equal(t, "US", countries[0].Alpha2)
+	// callers must not be able to change the registry
+	countries[0].Alpha2 = "XX"
+	countries[0].Phone.MobileBeginWith[0] = "0"
+	countries[0].Currencies[0] = "XXX"
+	assert.Equal(t, "US", GetCountries()[0].Alpha2)
+	assert.Equal(t, "12015550123", Parse("+12015550123", ""))
+	assert.Equal(t, "USD", GetCountries()[0].Currencies[0])
+
+	id, ok = LookupCountry("ID")
+	assert.True(t, ok)
+	id.Phone.PhoneNumberLengths[0] = 0
+	id.LocalizedNames["id"] = "Negeri"
+	id, _ = LookupCountry("ID")
+	assert.NotEqual(t, 0, id.Phone.PhoneNumberLengths[0])
+	assert.Equal(t, "Indonesia", id.LocalizedName("id"))
+}
+
+func TestLookupSubdivision(t *testing.T) {
+	testCases := []struct {
+		name, code, expected, parent string
+	}{
+		{name: "Testcase #1: Indonesian province", code: "ID-JK", expected: "Jakarta Raya", parent: "ID-JW"},
+		{name: "Testcase #2: Papua province created in 2022", code: "ID-PE", expected: "Papua Pegunungan", parent: "ID-PP"},
+		{name: "Testcase #3: Malaysian federal territory", code: "my-14", expected: "Wilayah Persekutuan Kuala Lumpur"},
+		{name: "Testcase #4: Singapore district", code: "SG-01", expected: "Central Singapore"},
+		{name: "Testcase #5: Thai province", code: "TH-10", expected: "Krung Thep Maha Nakhon"},
+		{name: "Testcase #6: unknown code", code: "ID-XX", expected: ""},
+		{name: "Testcase #7: country without subdivisions", code: "AQ-01", expected: ""},
+		{name: "Testcase #8: invalid code", code: "IDN-JK", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			subdivision, ok := LookupSubdivision(tc.code)
+			assert.Equal(t, tc.expected != "", ok)
+			assert.Equal(t, tc.expected, subdivision.Name)
+			assert.Equal(t, tc.parent, subdivision.Parent)
+		})
+	}
+}
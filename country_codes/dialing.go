@@ -0,0 +1,90 @@
+package countrycodes
+
+import (
+	"sort"
+	"strings"
+)
+
+// ownCallingCodePrefix prefix written before the calling code of the country itself, e.g. 00 62 in Indonesia,
+// accepted as international even when it is not one of the international prefixes of the country
+const ownCallingCodePrefix = "00"
+
+// DialFrom method for getting the digits to dial the phone number from country, which may be alpha2, alpha3
+// or country name. The national prefix is dialed within the calling code of the phone number and the preferred
+// international prefix of country otherwise, a country without international prefix dials the + sign
+func (p PhoneNumber) DialFrom(country string) (string, error) {
+	if p.NationalNumber == "" {
+		return "", ErrEmptyNumber
+	}
+	from, ok := getRegistry().byCountry(country)
+	if !ok {
+		return "", ErrUnknownCountry
+	}
+
+	if from.CountryCode == p.CountryCode {
+		return p.Country.NationalPrefix + p.NationalNumber, nil
+	}
+	if len(from.InternationalPrefixes) == 0 {
+		return "+" + p.String(), nil
+	}
+	return from.InternationalPrefixes[0] + p.String(), nil
+}
+
+// stripInternationalPrefix function for removing the international prefix of iso3166 from number,
+// the longest prefix followed by the calling code of a country accepting number wins,
+// then ownCallingCodePrefix followed by the calling code of iso3166
+func stripInternationalPrefix(number string, iso3166 ISO3166, options *parseOptions) (string, bool) {
+	prefixes := append([]string(nil), iso3166.InternationalPrefixes...)
+	sort.SliceStable(prefixes, func(i, j int) bool {
+		return len(prefixes[i]) > len(prefixes[j])
+	})
+	for _, prefix := range prefixes {
+		if prefix == "" || len(number) <= len(prefix) || number[:len(prefix)] != prefix {
+			continue
+		}
+		if getISO3166ByNumber(number[len(prefix):], options).Alpha2 != "" {
+			return number[len(prefix):], true
+		}
+	}
+	if strings.HasPrefix(number, ownCallingCodePrefix+iso3166.CountryCode) {
+		if rest := number[len(ownCallingCodePrefix):]; getISO3166ByNumber(rest, options).Alpha2 != "" {
+			return rest, true
+		}
+	}
+	return number, false
+}
+
+// stripNationalPrefix function for removing the national prefix of iso3166 from number
+// when the rest has a valid national number length
+func stripNationalPrefix(number string, iso3166 ISO3166) string {
+	prefix := iso3166.NationalPrefix
+	if prefix == "" || len(number) <= len(prefix) || number[:len(prefix)] != prefix {
+		return number
+	}
+	if indexOfInt(len(number)-len(prefix), iso3166.PhoneNumberLengths) == -1 {
+		return number
+	}
+	return number[len(prefix):]
+}
+
+// stripLeadingZeros function for removing zeros written before a national number by habit of a 0 national prefix,
+// e.g. 0 201 555 0123 in the United States, unless number is accepted with them as in Gabon.
+// Zeros written before the own calling code, e.g. 0 66 60 796 3193 in Thailand, are removed with it
+func stripLeadingZeros(number string, iso3166 ISO3166, options *parseOptions) string {
+	trimmed := strings.TrimLeft(number, "0")
+	if trimmed == number || trimmed == "" {
+		return number
+	}
+	if _, _, err := validatePhoneISO3166(iso3166.CountryCode+number, iso3166, options); err == nil {
+		return number
+	}
+	if _, _, err := validatePhoneISO3166(iso3166.CountryCode+trimmed, iso3166, options); err == nil {
+		return trimmed
+	}
+	if strings.HasPrefix(trimmed, iso3166.CountryCode) {
+		if nationalNumber, _, err := validatePhoneISO3166(trimmed, iso3166, options); err == nil {
+			return nationalNumber
+		}
+	}
+	return number
+}
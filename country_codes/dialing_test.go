@@ -0,0 +1,74 @@
+package countrycodes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDialingPrefixes(t *testing.T) {
+	testCases := []struct {
+		name, number, country, expected string
+	}{
+		{name: "Testcase #1: international prefix 00", number: "00 62 812 3456 7890", country: "ID", expected: "6281234567890"},
+		{name: "Testcase #2: international prefix 011", number: "011 44 7911 123456", country: "US", expected: "447911123456"},
+		{name: "Testcase #3: international prefix 810", number: "810 62 812 3456 7890", country: "RU", expected: "6281234567890"},
+		{name: "Testcase #4: longest international prefix", number: "001 1 201 555 0123", country: "ID", expected: "12015550123"},
+		{name: "Testcase #5: international prefix of another country", number: "011 62 812 3456 7890", country: "ID", expected: ""},
+		{name: "Testcase #6: national prefix 0", number: "0812 3456 7890", country: "ID", expected: "6281234567890"},
+		{name: "Testcase #7: national prefix 8", number: "8 777 123 4567", country: "KZ", expected: "77771234567"},
+		{name: "Testcase #8: national prefix 1", number: "1 201 555 0123", country: "US", expected: "12015550123"},
+		{name: "Testcase #9: leading 0 of a country without national prefix", number: "05 12 34 56", country: "GA", expected: "24105123456"},
+		{name: "Testcase #10: national number starting like the national prefix", number: "800 123 4567", country: "RU", expected: ""},
+		{name: "Testcase #11: 00 and own calling code", number: "006281234567890", country: "IDN", expected: "6281234567890"},
+		{name: "Testcase #12: 00 and own calling code without national prefix", number: "0065 9123 4567", country: "SG", expected: "6591234567"},
+		{name: "Testcase #13: international prefix 00 of another country", number: "00 62 812 3456 7890", country: "MY", expected: "6281234567890"},
+		{name: "Testcase #14: leading 0 of a country with national prefix 1", number: "02011779185", country: "USA", expected: "12011779185"},
+		{name: "Testcase #15: leading 0 of a country without national prefix", number: "0333 123 4567", country: "IT", expected: "393331234567"},
+		{name: "Testcase #16: leading 0 in Singapore", number: "091234567", country: "SG", expected: "6591234567"},
+		{name: "Testcase #17: leading 0 in Hong Kong", number: "05123 4567", country: "HK", expected: "85251234567"},
+		{name: "Testcase #18: leading 0 in Canada", number: "0204 234 5678", country: "CA", expected: "12042345678"},
+		{name: "Testcase #19: leading 0 and own calling code in Thailand", number: "066607963193", country: "TH", expected: "66607963193"},
+		{name: "Testcase #20: leading 0 and own calling code in the Netherlands", number: "031637281755", country: "NL", expected: "31637281755"},
+		{name: "Testcase #21: leading 0 and own calling code in Indonesia", number: "0 62 812 3456 7890", country: "ID", expected: "6281234567890"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Parse(tc.number, tc.country))
+		})
+	}
+}
+
+func TestDialFrom(t *testing.T) {
+	all := AllowTypes(NumberTypeMobile, NumberTypeFixedLine, NumberTypeTollFree)
+	id, err := ParsePhone("0812 3456 7890", "ID")
+	assert.NoError(t, err)
+	us, err := ParsePhone("+1 201 555 0123", "", all)
+	assert.NoError(t, err)
+	ca, err := ParsePhone("+1 204 234 5678", "", all)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name, from, expected string
+		number               PhoneNumber
+		err                  error
+	}{
+		{name: "Testcase #1: same country", number: id, from: "ID", expected: "081234567890"},
+		{name: "Testcase #2: international prefix 00", number: id, from: "MY", expected: "006281234567890"},
+		{name: "Testcase #3: international prefix 011", number: id, from: "US", expected: "0116281234567890"},
+		{name: "Testcase #4: preferred international prefix", number: us, from: "ID", expected: "00112015550123"},
+		{name: "Testcase #5: same calling code", number: ca, from: "US", expected: "12042345678"},
+		{name: "Testcase #6: country name", number: us, from: "Japan", expected: "01012015550123"},
+		{name: "Testcase #7: Negative, unknown country", number: us, from: "Atlantis", err: ErrUnknownCountry},
+		{name: "Testcase #8: Negative, empty number", number: PhoneNumber{}, from: "US", err: ErrEmptyNumber},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dial, err := tc.number.DialFrom(tc.from)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.expected, dial)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package countrycodes
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// minVanityLetters fewest letters of a vanity number, fewer letters are treated as noise
+const minVanityLetters = 3
+
+var (
+	// extensionPattern extension written after a phone number: ;ext=12, ext. 12, extension 12, x12 or #12
+	extensionPattern = regexp.MustCompile(`(?i)(?:;ext=|[\s,]*(?:ext(?:ension)?\.?|x|#)[\s:.]*)(\d{1,7})#?\s*$`)
+	// vanityPattern number starting with digits followed by keypad letters such as +1 800 FLOWERS
+	vanityPattern = regexp.MustCompile(`^\+?[\d\s().\-/]*\d[\d\s().\-/]*[A-Za-z][A-Za-z\d\s().\-/]*$`)
+	// keypadReplacer replacer of letters by the digit of their phone keypad key
+	keypadReplacer = strings.NewReplacer(keypadPairs()...)
+)
+
+// splitExtension function for splitting number into the number itself and its extension,
+// a marker is only an extension when digits come before it and a word marker such as x is not
+// glued to letters before it, e.g. the x of the vanity number +1 800 MAX1234
+func splitExtension(number string) (string, string) {
+	loc := extensionPattern.FindStringSubmatchIndex(number)
+	if loc == nil || digitsOnly(number[:loc[0]]) == "" {
+		return number, ""
+	}
+	if marker, _ := utf8.DecodeRuneInString(number[loc[0]:]); unicode.IsLetter(marker) {
+		if before, _ := utf8.DecodeLastRuneInString(number[:loc[0]]); unicode.IsLetter(before) {
+			return number, ""
+		}
+	}
+	return number[:loc[0]], number[loc[2]:loc[3]]
+}
+
+// vanityDigits function for converting the letters of vanity number to keypad digits,
+// false is returned with number unchanged when it is not a vanity number
+func vanityDigits(number string) (string, bool) {
+	if !vanityPattern.MatchString(number) {
+		return number, false
+	}
+	letters := 0
+	for _, r := range number {
+		if r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' {
+			letters++
+		}
+	}
+	if letters < minVanityLetters {
+		return number, false
+	}
+	return keypadReplacer.Replace(number), true
+}
+
+// keypadPairs function for getting the old, new pairs of keypadReplacer in both letter cases
+func keypadPairs() []string {
+	keys := map[string]string{"2": "ABC", "3": "DEF", "4": "GHI", "5": "JKL", "6": "MNO", "7": "PQRS", "8": "TUV", "9": "WXYZ"}
+	var pairs []string
+	for digit, letters := range keys {
+		for _, l := range letters {
+			pairs = append(pairs, string(l), digit, strings.ToLower(string(l)), digit)
+		}
+	}
+	return pairs
+}
@@ -0,0 +1,72 @@
+package countrycodes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitExtension(t *testing.T) {
+	testCases := []struct {
+		name, input, number, extension string
+	}{
+		{name: "Testcase #1: ext.", input: "+62 21 555 1234 ext. 12", number: "+62 21 555 1234", extension: "12"},
+		{name: "Testcase #2: extension", input: "+62 21 555 1234, Extension: 12", number: "+62 21 555 1234", extension: "12"},
+		{name: "Testcase #3: x", input: "(201) 555-0123 x12", number: "(201) 555-0123", extension: "12"},
+		{name: "Testcase #4: #", input: "(201) 555-0123 #12#", number: "(201) 555-0123", extension: "12"},
+		{name: "Testcase #5: RFC3966", input: "+1-201-555-0123;ext=12", number: "+1-201-555-0123", extension: "12"},
+		{name: "Testcase #6: no extension", input: "+62 21 555 1234", number: "+62 21 555 1234"},
+		{name: "Testcase #7: marker without number", input: "#12", number: "#12"},
+		{name: "Testcase #8: vanity letters", input: "+1 800 FLOWERS", number: "+1 800 FLOWERS"},
+		{name: "Testcase #9: x inside vanity letters", input: "+1 800 MAX1234", number: "+1 800 MAX1234"},
+		{name: "Testcase #10: x right after digits", input: "(201) 555-0123x12", number: "(201) 555-0123", extension: "12"},
+		{name: "Testcase #11: ext inside a word", input: "+1 800 NEXT12", number: "+1 800 NEXT12"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			number, extension := splitExtension(tc.input)
+			assert.Equal(t, tc.number, number)
+			assert.Equal(t, tc.extension, extension)
+		})
+	}
+}
+
+func TestParsePhoneExtensionAndVanity(t *testing.T) {
+	all := AllowTypes(NumberTypeMobile, NumberTypeFixedLine, NumberTypeTollFree, NumberTypeUnknown)
+	testCases := []struct {
+		name, number, country, expected, extension string
+		err                                        error
+	}{
+		{name: "Testcase #1: extension", number: "+62 21 555 1234 ext. 12", expected: "62215551234", extension: "12"},
+		{name: "Testcase #2: national number with extension", number: "(021) 555-1234 x 12", country: "ID", expected: "62215551234", extension: "12"},
+		{name: "Testcase #3: vanity number", number: "+1 800 FLOWERS", expected: "18003569377"},
+		{name: "Testcase #4: national vanity number", number: "1-800-flowers", country: "US", expected: "18003569377"},
+		{name: "Testcase #5: vanity number with extension", number: "+1 800 FLOWERS ext. 5", expected: "18003569377", extension: "5"},
+		{name: "Testcase #6: Negative, vanity number of a country without vanity numbers", number: "021 CALL NOW", country: "ID", err: ErrVanityNotAllowed},
+		{name: "Testcase #7: name label", number: "0812 3456 7890 (Budi)", country: "ID", expected: "6281234567890"},
+		{name: "Testcase #8: word label", number: "0812-3456-7890 kantor", country: "ID", expected: "6281234567890"},
+		{name: "Testcase #9: label in a country with vanity numbers", number: "(201) 555-0123 home", country: "US", expected: "12015550123"},
+		{name: "Testcase #10: label and extension", number: "(201) 555-0123 office ext. 12", country: "US", expected: "12015550123", extension: "12"},
+		{name: "Testcase #11: vanity number with x", number: "+1 800 MAX1234", expected: "18006291234"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := ParsePhone(tc.number, tc.country, all)
+			assert.True(t, errors.Is(err, tc.err), "got %v", err)
+			assert.Equal(t, tc.expected, p.String())
+			assert.Equal(t, tc.extension, p.Extension)
+		})
+	}
+}
+
+func TestParsePhoneVanityError(t *testing.T) {
+	// the digits without letters are too short, the error of the vanity number is reported
+	_, err := ParsePhone("+1 800 FLOWERS", "")
+	assert.True(t, errors.Is(err, ErrNotMobile), "got %v", err)
+
+	_, err = ParsePhone("+1 800 FLOWERS", "", AllowTypes(NumberTypeFixedLine))
+	assert.True(t, errors.Is(err, ErrNumberTypeNotAllowed), "got %v", err)
+}
@@ -0,0 +1,254 @@
+package countrycodes
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Leniency level of validation of numbers found in text
+type Leniency int
+
+const (
+	// LeniencyPossible leniency for numbers of a known calling code and a possible length
+	LeniencyPossible Leniency = iota
+	// LeniencyValid leniency for numbers ParsePhone accepts
+	LeniencyValid
+	// LeniencyStrictGrouping leniency for valid numbers whose digit groups follow the country number format,
+	// fewer groups are accepted but a run of digits without any separator is not
+	LeniencyStrictGrouping
+)
+
+const (
+	// minFindDigits fewest digits of a number found in text
+	minFindDigits = 7
+	// maxFindDigits most digits of a number found in text, the E.164 maximum
+	maxFindDigits = 15
+)
+
+var (
+	// findCandidateRegexp regex for a run of digits with phone number punctuation
+	findCandidateRegexp = regexp.MustCompile(`\+?\(?\d[\d \t().\-]*\d`)
+	// findDateRegexp regex for dates such as 2021-09-21, 21.09.2021 or 21-9-21
+	findDateRegexp = regexp.MustCompile(`^(?:\d{4}[-.]\d{1,2}[-.]\d{1,2}|\d{1,2}[-.]\d{1,2}[-.](?:\d{4}|\d{2}))$`)
+	// findAmountRegexp regex for amounts with thousands separators or decimals such as 1.250.000 or 12,500.00
+	findAmountRegexp = regexp.MustCompile(`^\d{1,3}(?:[.,]\d{3})+(?:[.,]\d{1,2})?$|^\d+[.,]\d{1,2}$`)
+	// findDateTimeRegexp regex for a date followed by a time such as 2021-09-21 14.30 or 21.09.2021 14:30
+	findDateTimeRegexp = regexp.MustCompile(`^(?:\d{4}[-.]\d{1,2}[-.]\d{1,2}|\d{1,2}[-.]\d{1,2}[-.](?:\d{4}|\d{2}))[ \tT]+\d{1,2}[:.]\d{2}`)
+	// findCurrencies words and symbols found right before an amount
+	findCurrencies = []string{"$", "€", "£", "¥", "rp", "rp.", "idr", "usd", "sgd", "myr", "eur"}
+	// findLabels words found right before an id, a version or an amount
+	findLabels = []string{"id", "order", "invoice", "inv", "nik", "ktp", "npwp", "ref", "version", "harga", "total", "price"}
+	// findCurrencyWords words found right after an amount
+	findCurrencyWords = []string{"rupiah", "rp", "idr", "usd", "sgd", "myr", "eur", "dollar", "dollars", "euro", "euros", "ribu", "juta"}
+)
+
+// NumberMatch data structure of a phone number found in text, Start and End are byte offsets of RawString
+type NumberMatch struct {
+	Start     int
+	End       int
+	RawString string
+	Number    PhoneNumber
+}
+
+// FindNumbers function for getting every phone number of text at leniency, country is used for numbers
+// without + sign like in ParsePhone and opts select the accepted number types.
+// Runs of digits glued to letters, following # or a currency, labelled as an id or an amount,
+// and dates, times and amounts are never numbers
+func FindNumbers(text, country string, leniency Leniency, opts ...ParseOption) []NumberMatch {
+	if leniency == LeniencyPossible {
+		opts = append(opts, func(o *parseOptions) {
+			o.types = append(o.types, NumberTypeUnknown)
+		})
+	}
+
+	var matches []NumberMatch
+	for _, loc := range findCandidateRegexp.FindAllStringIndex(text, -1) {
+		matches = append(matches, findInCandidate(text, loc[0], loc[1], country, leniency, opts)...)
+	}
+	return matches
+}
+
+// findInCandidate function for getting the numbers of candidate text[start:end], a candidate with too many
+// digits such as numbers separated by spaces is split at whitespace into its longest leading numbers
+// unless it follows a label
+func findInCandidate(text string, start, end int, country string, leniency Leniency, opts []ParseOption) []NumberMatch {
+	// the digit groups of a labelled candidate such as NIK 3174 0512 3456 7890 are one id
+	if followsLabel(text, start) {
+		return nil
+	}
+
+	var matches []NumberMatch
+	for start < end {
+		raw := trimCandidate(text[start:end])
+		if len(digitsOnly(raw)) <= maxFindDigits {
+			if m, ok := findNumber(text, start, start+len(raw), country, leniency, opts); ok {
+				matches = append(matches, m)
+			}
+			return matches
+		}
+
+		splits := whitespaceIndexes(raw)
+		if len(splits) == 0 {
+			return matches
+		}
+		next := start + splits[0]
+		for k := len(splits) - 1; k >= 0; k-- {
+			part := trimCandidate(raw[:splits[k]])
+			if m, ok := findNumber(text, start, start+len(part), country, leniency, opts); ok {
+				matches = append(matches, m)
+				next = start + splits[k]
+				break
+			}
+		}
+		start = end - len(strings.TrimLeft(text[next:end], " \t"))
+	}
+	return matches
+}
+
+// findNumber function for getting the number of candidate text[start:end] at leniency
+func findNumber(text string, start, end int, country string, leniency Leniency, opts []ParseOption) (NumberMatch, bool) {
+	if !isCandidate(text, start, end) {
+		return NumberMatch{}, false
+	}
+	raw := text[start:end]
+	number, err := ParsePhone(raw, country, opts...)
+	if err != nil {
+		return NumberMatch{}, false
+	}
+	if leniency == LeniencyStrictGrouping && !strictlyGrouped(raw, number) {
+		return NumberMatch{}, false
+	}
+	return NumberMatch{Start: start, End: end, RawString: raw, Number: number}, true
+}
+
+// whitespaceIndexes function for getting the index of every run of spaces and tabs of raw
+func whitespaceIndexes(raw string) []int {
+	var indexes []int
+	for k := 0; k < len(raw); k++ {
+		if (raw[k] == ' ' || raw[k] == '\t') && (k == 0 || raw[k-1] != ' ' && raw[k-1] != '\t') {
+			indexes = append(indexes, k)
+		}
+	}
+	return indexes
+}
+
+// trimCandidate function for dropping trailing punctuation and unbalanced parentheses of a candidate
+func trimCandidate(raw string) string {
+	for {
+		trimmed := strings.TrimRight(raw, " \t.-(")
+		if strings.Count(trimmed, ")") > strings.Count(trimmed, "(") && strings.HasSuffix(trimmed, ")") {
+			trimmed = trimmed[:len(trimmed)-1]
+		}
+		if trimmed == raw {
+			return raw
+		}
+		raw = trimmed
+	}
+}
+
+// isCandidate function for checking that text[start:end] is not part of a word, an id, a date or an amount
+func isCandidate(text string, start, end int) bool {
+	raw := text[start:end]
+	digits := len(digitsOnly(raw))
+	if digits < minFindDigits || digits > maxFindDigits {
+		return false
+	}
+
+	if before, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 {
+		if unicode.IsLetter(before) || unicode.IsDigit(before) || before == '#' || before == '_' || before == '/' {
+			return false
+		}
+	}
+	if after, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) {
+		if unicode.IsLetter(after) || unicode.IsDigit(after) || after == '_' || after == '/' || after == '%' {
+			return false
+		}
+	}
+
+	compact := strings.TrimSpace(raw)
+	if findDateRegexp.MatchString(compact) || findAmountRegexp.MatchString(compact) || findDateTimeRegexp.MatchString(text[start:]) {
+		return false
+	}
+
+	words := strings.Fields(strings.ToLower(text[:start]))
+	if len(words) > 0 {
+		last := words[len(words)-1]
+		for _, currency := range findCurrencies {
+			// a symbol may be glued to other characters, e.g. US$
+			if last == currency || utf8.RuneCountInString(currency) == 1 && strings.HasSuffix(last, currency) {
+				return false
+			}
+		}
+	}
+	if followsLabel(text, start) {
+		return false
+	}
+	if words := strings.Fields(strings.ToLower(text[end:])); len(words) > 0 {
+		if indexOfString(strings.TrimRight(words[0], ".,;!?"), findCurrencyWords) != -1 {
+			return false
+		}
+	}
+	return true
+}
+
+// followsLabel function for checking that the word right before text[start:] labels an id, a version or an amount
+func followsLabel(text string, start int) bool {
+	words := strings.Fields(strings.ToLower(text[:start]))
+	return len(words) > 0 && indexOfString(strings.TrimRight(words[len(words)-1], ":#."), findLabels) != -1
+}
+
+// strictlyGrouped function for checking that digit groups of raw are groups of the national or
+// international format of number, with or without national prefix and calling code,
+// or concatenations of consecutive ones, raw without any separator is not grouped
+func strictlyGrouped(raw string, number PhoneNumber) bool {
+	groups := digitGroups(raw)
+	if len(groups) < 2 {
+		return false
+	}
+	if len(number.Country.NumberFormats) == 0 {
+		return true
+	}
+
+	national := digitGroups(number.Format(National))
+	international := digitGroups(number.Format(International))
+	candidates := [][]string{national, international, international[1:]}
+	if prefix := number.Country.NationalPrefix; prefix != "" && len(national) > 0 && strings.HasPrefix(national[0], prefix) {
+		withoutPrefix := append([]string{strings.TrimPrefix(national[0], prefix)}, national[1:]...)
+		if withoutPrefix[0] == "" {
+			withoutPrefix = withoutPrefix[1:]
+		}
+		candidates = append(candidates, withoutPrefix, append([]string{number.CountryCode}, withoutPrefix...))
+	}
+
+	for _, formatted := range candidates {
+		if coarserGroups(groups, formatted) {
+			return true
+		}
+	}
+	return false
+}
+
+// digitGroups function for getting the runs of digits of s
+func digitGroups(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+}
+
+// coarserGroups function for checking that every group is a concatenation of consecutive formatted groups
+func coarserGroups(groups, formatted []string) bool {
+	k := 0
+	for _, g := range groups {
+		joined := ""
+		for joined != g && k < len(formatted) && len(joined) < len(g) {
+			joined += formatted[k]
+			k++
+		}
+		if joined != g {
+			return false
+		}
+	}
+	return k == len(formatted)
+}
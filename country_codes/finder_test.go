@@ -0,0 +1,110 @@
+package countrycodes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindNumbers(t *testing.T) {
+	all := AllowTypes(NumberTypeMobile, NumberTypeFixedLine, NumberTypeTollFree,
+		NumberTypePremiumRate, NumberTypeSharedCost, NumberTypeVoIP)
+
+	testCases := []struct {
+		name, text, country string
+		leniency            Leniency
+		opts                []ParseOption
+		expected            []string
+	}{
+		{name: "Testcase #1: national and international numbers", country: "ID", leniency: LeniencyValid,
+			text:     "Hubungi 0812-3456-7890 atau +62 813 1111 2222, terima kasih.",
+			expected: []string{"0812-3456-7890", "+62 813 1111 2222"}},
+		{name: "Testcase #2: parentheses", country: "US", leniency: LeniencyValid,
+			text:     "Call me at (201) 555-0123.",
+			expected: []string{"(201) 555-0123"}},
+		{name: "Testcase #3: dates, order ids and prices", country: "ID", leniency: LeniencyPossible,
+			text:     "Order #081234567890 on 2021-09-21 cost Rp 1.250.000 or Rp81234567890, ref INV081234567890 and 21.09.2021",
+			expected: nil},
+		{name: "Testcase #4: possible accepts any prefix", country: "ID", leniency: LeniencyPossible,
+			text:     "kantor 0512345678",
+			expected: []string{"0512345678"}},
+		{name: "Testcase #5: valid rejects type not allowed", country: "ID", leniency: LeniencyValid,
+			text:     "kantor 0512345678",
+			expected: nil},
+		{name: "Testcase #6: valid with allowed types", country: "ID", leniency: LeniencyValid, opts: []ParseOption{all},
+			text:     "kantor 0512345678",
+			expected: []string{"0512345678"}},
+		{name: "Testcase #7: strict grouping", country: "ID", leniency: LeniencyStrictGrouping,
+			text:     "a 0812-3456-7890 b 081234567890 c +62 812 3456 7890 d 0812-345-67890 e 812 3456 7890",
+			expected: []string{"0812-3456-7890", "+62 812 3456 7890", "812 3456 7890"}},
+		{name: "Testcase #8: too short and too long", country: "ID", leniency: LeniencyPossible,
+			text:     "pin 123456 and 1234567890123456",
+			expected: nil},
+		{name: "Testcase #9: adjacent numbers", country: "ID", leniency: LeniencyValid,
+			text:     "0812-3456-7890 0813-1111-2222",
+			expected: []string{"0812-3456-7890", "0813-1111-2222"}},
+		{name: "Testcase #10: adjacent ungrouped numbers", country: "US", leniency: LeniencyValid,
+			text:     "call 2015550123 2015550124",
+			expected: []string{"2015550123", "2015550124"}},
+		{name: "Testcase #11: adjacent numbers with spaces", country: "ID", leniency: LeniencyValid,
+			text:     "+62 812 3456 7890 0813 1111 2222",
+			expected: []string{"+62 812 3456 7890", "0813 1111 2222"}},
+		{name: "Testcase #12: number after a too long run", country: "ID", leniency: LeniencyValid,
+			text:     "serial 1234567890123456 0812 3456 7890",
+			expected: []string{"0812 3456 7890"}},
+		{name: "Testcase #13: Negative, order id", country: "ID", leniency: LeniencyPossible,
+			text:     "order id 20210921001",
+			expected: nil},
+		{name: "Testcase #14: Negative, order id at valid", country: "ID", leniency: LeniencyValid,
+			text:     "order id 20210921001",
+			expected: nil},
+		{name: "Testcase #15: Negative, order id at strict grouping", country: "ID", leniency: LeniencyStrictGrouping,
+			text:     "order id 20210921001",
+			expected: nil},
+		{name: "Testcase #16: Negative, date and time", country: "ID", leniency: LeniencyValid,
+			text:     "Meeting on 2021-09-21 14.30",
+			expected: nil},
+		{name: "Testcase #17: Negative, invoice number", country: "ID", leniency: LeniencyValid,
+			text:     "Invoice 2021 0921 1430",
+			expected: nil},
+		{name: "Testcase #18: Negative, amount with currency word", country: "ID", leniency: LeniencyPossible,
+			text:     "Total: 1 250 000 rupiah",
+			expected: nil},
+		{name: "Testcase #19: Negative, price", country: "ID", leniency: LeniencyPossible,
+			text:     "harga 125000000 rupiah",
+			expected: nil},
+		{name: "Testcase #20: Negative, identity number", country: "ID", leniency: LeniencyPossible,
+			text:     "NIK 3174 0512 3456 7890",
+			expected: nil},
+		{name: "Testcase #21: Negative, version", country: "ID", leniency: LeniencyPossible,
+			text:     "version 1.2.3.4567890",
+			expected: nil},
+		{name: "Testcase #22: Negative, ungrouped digits at strict grouping", country: "ID", leniency: LeniencyStrictGrouping,
+			text:     "call 081234567890 or +6281234567890",
+			expected: nil},
+		{name: "Testcase #23: date and time with colon", country: "ID", leniency: LeniencyValid,
+			text:     "2021-09-21 14:30 WA 0812-3456-7890",
+			expected: []string{"0812-3456-7890"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, m := range FindNumbers(tc.text, tc.country, tc.leniency, tc.opts...) {
+				assert.Equal(t, m.RawString, tc.text[m.Start:m.End])
+				got = append(got, m.RawString)
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestFindNumbersResult(t *testing.T) {
+	text := "WA: +62 812-3456-7890"
+	matches := FindNumbers(text, "", LeniencyValid)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, 4, matches[0].Start)
+	assert.Equal(t, len(text), matches[0].End)
+	assert.Equal(t, "ID", matches[0].Number.Country.Alpha2)
+	assert.Equal(t, "81234567890", matches[0].Number.NationalNumber)
+}
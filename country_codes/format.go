@@ -0,0 +1,99 @@
+package countrycodes
+
+import (
+	"strings"
+)
+
+// PhoneNumberFormat style of rendering a phone number
+type PhoneNumberFormat int
+
+const (
+	// E164 format for +6281234567890
+	E164 PhoneNumberFormat = iota
+	// International format for +62 812-3456-7890
+	International
+	// National format for 0812-3456-7890
+	National
+	// RFC3966 format for tel:+62-812-3456-7890
+	RFC3966
+)
+
+// nationalPrefixPlaceholder placeholder of NumberFormat.Format replaced by the country national prefix
+const nationalPrefixPlaceholder = "$NP"
+
+// NumberFormat data structure of a grouping pattern for national numbers,
+// Pattern is a regular expression matched against the whole national number and
+// Format references its groups as $1, $2 ... and the national prefix as $NP.
+// InternationalFormat is used for International and RFC3966, Format without $NP when empty.
+// LeadingDigits is an optional regular expression the start of the national number must match
+type NumberFormat struct {
+	Pattern             string
+	Format              string
+	InternationalFormat string
+	LeadingDigits       string
+}
+
+// Format method for rendering phone number in the given style followed by its extension,
+// a national number without matching NumberFormat is rendered ungrouped
+func (p PhoneNumber) Format(style PhoneNumberFormat) string {
+	switch style {
+	case International:
+		return "+" + p.CountryCode + " " + p.groupNumber(true) + p.formatExtension(" ext. ")
+	case National:
+		return p.groupNumber(false) + p.formatExtension(" ext. ")
+	case RFC3966:
+		grouped := strings.NewReplacer(" ", "-", "(", "", ")", "").Replace(p.groupNumber(true))
+		return "tel:+" + p.CountryCode + "-" + grouped + p.formatExtension(";ext=")
+	}
+	return "+" + p.CountryCode + p.NationalNumber + p.formatExtension(";ext=")
+}
+
+// formatExtension method for getting the extension after marker, empty for a number without extension
+func (p PhoneNumber) formatExtension(marker string) string {
+	if p.Extension == "" {
+		return ""
+	}
+	return marker + p.Extension
+}
+
+// groupNumber method for applying the first matching country NumberFormat to the national number
+func (p PhoneNumber) groupNumber(international bool) string {
+	for _, f := range p.Country.NumberFormats {
+		r := getRegistry().pattern(f.Pattern)
+		if r == nil || !r.MatchString(p.NationalNumber) {
+			continue
+		}
+		if f.LeadingDigits != "" {
+			leading := getRegistry().pattern(leadingDigitsPattern(f.LeadingDigits))
+			if leading == nil || !leading.MatchString(p.NationalNumber) {
+				continue
+			}
+		}
+
+		template := formatTemplate(f, international, p.Country.NationalPrefix)
+		return strings.TrimSpace(r.ReplaceAllString(p.NationalNumber, template))
+	}
+
+	if international {
+		return p.NationalNumber
+	}
+	return p.Country.NationalPrefix + p.NationalNumber
+}
+
+// formatTemplate function for getting the replacement template of f, with nationalPrefix
+// in place of $NP for national format
+func formatTemplate(f NumberFormat, international bool, nationalPrefix string) string {
+	if international {
+		template := f.Format
+		if f.InternationalFormat != "" {
+			template = f.InternationalFormat
+		}
+		return strings.Replace(template, nationalPrefixPlaceholder, "", -1)
+	}
+	return strings.Replace(f.Format, nationalPrefixPlaceholder, nationalPrefix, -1)
+}
+
+// leadingDigitsPattern function for anchoring NumberFormat.LeadingDigits at the start of the national number
+func leadingDigitsPattern(leadingDigits string) string {
+	return `^(?:` + leadingDigits + `)`
+}
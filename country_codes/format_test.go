@@ -0,0 +1,39 @@
+package countrycodes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhoneNumberFormat(t *testing.T) {
+	testCases := []struct {
+		name, number, country string
+		style                 PhoneNumberFormat
+		expected              string
+	}{
+		{name: "Testcase #1: E164", number: "0812 3456 7890", country: "ID", style: E164, expected: "+6281234567890"},
+		{name: "Testcase #2: International", number: "0812 3456 7890", country: "ID", style: International, expected: "+62 812-3456-7890"},
+		{name: "Testcase #3: National", number: "0812 3456 7890", country: "ID", style: National, expected: "0812-3456-7890"},
+		{name: "Testcase #4: RFC3966", number: "0812 3456 7890", country: "ID", style: RFC3966, expected: "tel:+62-812-3456-7890"},
+		{name: "Testcase #5: International NANP", number: "2015550123", country: "US", style: International, expected: "+1 201-555-0123"},
+		{name: "Testcase #6: National NANP", number: "2015550123", country: "US", style: National, expected: "(201) 555-0123"},
+		{name: "Testcase #7: National NANP shared data", number: "4165550123", country: "CA", style: National, expected: "(416) 555-0123"},
+		{name: "Testcase #8: National without prefix", number: "+65 9123 4567", style: National, expected: "9123 4567"},
+		{name: "Testcase #9: National Russia", number: "+7 912 345 67 89", style: National, expected: "8 (912) 345-67-89"},
+		{name: "Testcase #10: RFC3966 Malaysia", number: "012-345 6789", country: "MY", style: RFC3966, expected: "tel:+60-12-345-6789"},
+		{name: "Testcase #11: no format data", number: "+297 560 1234", style: International, expected: "+297 5601234"},
+		{name: "Testcase #12: E164 extension", number: "0812 3456 7890 ext. 12", country: "ID", style: E164, expected: "+6281234567890;ext=12"},
+		{name: "Testcase #13: International extension", number: "0812 3456 7890 x12", country: "ID", style: International, expected: "+62 812-3456-7890 ext. 12"},
+		{name: "Testcase #14: National extension", number: "0812 3456 7890 #12", country: "ID", style: National, expected: "0812-3456-7890 ext. 12"},
+		{name: "Testcase #15: RFC3966 extension", number: "+62 812 3456 7890;ext=12", style: RFC3966, expected: "tel:+62-812-3456-7890;ext=12"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := ParsePhone(tc.number, tc.country)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, p.Format(tc.style))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package countrycodes
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// languageAliases base languages of deprecated and ISO 639-2/3 codes of the localized languages
+var languageAliases = map[string]string{
+	"in":  "id",
+	"ind": "id",
+	"msa": "ms",
+	"may": "ms",
+	"zsm": "ms",
+	"zlm": "ms",
+	"tha": "th",
+	"eng": "en",
+}
+
+// LocalizedName method for getting the country name in the language of tag, such as id, ms-MY or th_TH.UTF-8,
+// only the base language of tag is used and the english name is returned when it has no localized name
+func (c Country) LocalizedName(tag string) string {
+	if name, ok := c.LocalizedNames[baseLanguage(tag)]; ok {
+		return name
+	}
+	return c.Name
+}
+
+// SortedCountries function for getting a copy of every country sorted by its name in the language of tag,
+// see LocalizedName for the fallback rules
+func SortedCountries(tag string) []Country {
+	countries := GetCountries()
+	keys := make(map[string]string, len(countries))
+	for _, c := range countries {
+		keys[c.Alpha2] = collationKey(c.LocalizedName(tag))
+	}
+	sort.SliceStable(countries, func(i, j int) bool {
+		ki, kj := keys[countries[i].Alpha2], keys[countries[j].Alpha2]
+		if ki != kj {
+			return ki < kj
+		}
+		return countries[i].Alpha2 < countries[j].Alpha2
+	})
+	return countries
+}
+
+// baseLanguage function for getting the lowercase base language of a BCP 47 or POSIX locale tag
+func baseLanguage(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_.@"); i != -1 {
+		tag = tag[:i]
+	}
+	if base, ok := languageAliases[tag]; ok {
+		return base
+	}
+	return tag
+}
+
+// localizedNames function for getting the localized names of country c ordered by language
+func localizedNames(c Country) []string {
+	languages := make([]string, 0, len(c.LocalizedNames))
+	for language := range c.LocalizedNames {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	names := make([]string, len(languages))
+	for k, language := range languages {
+		names[k] = c.LocalizedNames[language]
+	}
+	return names
+}
+
+// latinFolds base letters of accented latin letters
+var latinFolds = map[rune]rune{
+	'à': 'a', 'á': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a', 'å': 'a',
+	'ç': 'c',
+	'è': 'e', 'é': 'e', 'ê': 'e', 'ë': 'e',
+	'ì': 'i', 'í': 'i', 'î': 'i', 'ï': 'i',
+	'ñ': 'n',
+	'ò': 'o', 'ó': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o', 'ø': 'o',
+	'ù': 'u', 'ú': 'u', 'û': 'u', 'ü': 'u',
+	'ý': 'y', 'ÿ': 'y',
+}
+
+// collationKey function for getting the key sorting names in dictionary order. Letters are compared
+// case and accent insensitively, punctuation is ignored and a Thai leading vowel is sorted after
+// the consonant it is written before
+func collationKey(name string) string {
+	runes := []rune(strings.ToLower(name))
+	key := make([]rune, 0, len(runes))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if isThaiLeadingVowel(r) && i+1 < len(runes) {
+			key = append(key, runes[i+1], r)
+			i++
+			continue
+		}
+		if folded, ok := latinFolds[r]; ok {
+			r = folded
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || r == ' ' {
+			key = append(key, r)
+		}
+	}
+	return string(key)
+}
+
+// isThaiLeadingVowel function for checking whether r is one of the Thai vowels เ แ โ ใ ไ
+func isThaiLeadingVowel(r rune) bool {
+	return r >= 'เ' && r <= 'ไ'
+}
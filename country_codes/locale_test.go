@@ -0,0 +1,101 @@
+package countrycodes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalizedName(t *testing.T) {
+	us, _ := LookupCountry("US")
+	id, _ := LookupCountry("ID")
+	tr, _ := LookupCountry("TR")
+	tl, _ := LookupCountry("TL")
+
+	testCases := []struct {
+		name, tag, expected string
+		country             Country
+	}{
+		{name: "Testcase #1: indonesian", country: us, tag: "id", expected: "Amerika Serikat"},
+		{name: "Testcase #2: indonesian with region", country: us, tag: "id-ID", expected: "Amerika Serikat"},
+		{name: "Testcase #3: deprecated indonesian code", country: us, tag: "in", expected: "Amerika Serikat"},
+		{name: "Testcase #4: malay posix locale", country: us, tag: "ms_MY.UTF-8", expected: "Amerika Syarikat"},
+		{name: "Testcase #5: thai", country: us, tag: "TH", expected: "สหรัฐ"},
+		{name: "Testcase #6: same name as english", country: id, tag: "id", expected: "Indonesia"},
+		{name: "Testcase #7: english", country: us, tag: "en-US", expected: "United States"},
+		{name: "Testcase #8: unknown language", country: us, tag: "fr", expected: "United States"},
+		{name: "Testcase #9: empty tag", country: us, tag: "", expected: "United States"},
+		{name: "Testcase #10: thai renamed country", country: tr, tag: "th", expected: "ตุรกี"},
+		{name: "Testcase #11: malay", country: tr, tag: "ms", expected: "Turki"},
+		{name: "Testcase #12: indonesian", country: tl, tag: "id", expected: "Timor Leste"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.country.LocalizedName(tc.tag))
+		})
+	}
+}
+
+func TestLookupLocalizedName(t *testing.T) {
+	testCases := []struct {
+		name, country, expected string
+	}{
+		{name: "Testcase #1: indonesian", country: "Amerika Serikat", expected: "US"},
+		{name: "Testcase #2: malay", country: "amerika syarikat", expected: "US"},
+		{name: "Testcase #3: thai", country: "ญี่ปุ่น", expected: "JP"},
+		{name: "Testcase #4: thai vowels are significant", country: "ไทย", expected: "TH"},
+		{name: "Testcase #5: malay korea", country: "Korea Selatan", expected: "KR"},
+		{name: "Testcase #6: malay turkey", country: "Turki", expected: "TR"},
+		{name: "Testcase #7: thai renamed country", country: "เอสวาตินี", expected: "SZ"},
+		{name: "Testcase #8: indonesian timor leste", country: "Timor Leste", expected: "TL"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			country, ok := LookupCountry(tc.country)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, country.Alpha2)
+		})
+	}
+
+	assert.Equal(t, "12025550123", Parse("202 555 0123", "Amerika Serikat"))
+	assert.Equal(t, "601123456789", Parse("011 2345 6789", "มาเลเซีย"))
+}
+
+func TestSortedCountries(t *testing.T) {
+	indexOf := func(countries []Country, alpha2 string) int {
+		for k, c := range countries {
+			if c.Alpha2 == alpha2 {
+				return k
+			}
+		}
+		return -1
+	}
+
+	english := SortedCountries("en")
+	assert.Len(t, english, 249)
+	assert.Equal(t, "AF", english[0].Alpha2)
+	// accents are ignored, Åland Islands sorts with the A
+	assert.Less(t, indexOf(english, "AX"), indexOf(english, "AL"))
+
+	indonesian := SortedCountries("id")
+	assert.Equal(t, "Afganistan", indonesian[0].LocalizedName("id"))
+	// Amerika Serikat sorts with the A in indonesian
+	assert.Less(t, indexOf(indonesian, "US"), indexOf(indonesian, "AO"))
+	assert.Greater(t, indexOf(english, "US"), indexOf(english, "TH"))
+
+	// a Thai leading vowel sorts after its consonant, เยอรมนี (Germany) sorts with ย
+	thai := SortedCountries("th")
+	assert.Less(t, indexOf(thai, "MY"), indexOf(thai, "DE"))
+	assert.Less(t, indexOf(thai, "DE"), indexOf(thai, "RU"))
+
+	// unknown language falls back to english order
+	assert.Equal(t, english, SortedCountries("xx"))
+}
+
+func TestCollationKey(t *testing.T) {
+	assert.Equal(t, "cote divoire", collationKey("Côte d'Ivoire"))
+	assert.Equal(t, "guineabissau", collationKey("Guinea-Bissau"))
+	assert.Equal(t, "ยเอรมนี", collationKey("เยอรมนี"))
+}
@@ -0,0 +1,104 @@
+package countrycodes
+
+import "strings"
+
+// MatchLevel how closely two phone numbers match
+type MatchLevel int
+
+// minShortMatchDigits fewest digits of a national number ending the other one for MatchLevelShortNSN,
+// the length of a local number without area code
+const minShortMatchDigits = 7
+
+const (
+	// MatchLevelNone level of different numbers, or of numbers with different extensions
+	MatchLevelNone MatchLevel = iota
+	// MatchLevelShortNSN level of numbers whose national significant number of at least 7 digits ends the other one,
+	// such as a local number written without area code, or of the same number with an extension on one side only
+	MatchLevelShortNSN
+	// MatchLevelNSN level of numbers with the same national significant number and extension,
+	// the calling code of one of them is unknown
+	MatchLevelNSN
+	// MatchLevelExact level of numbers with the same calling code, national significant number and extension
+	MatchLevelExact
+)
+
+// String method for getting the name of the match level
+func (l MatchLevel) String() string {
+	switch l {
+	case MatchLevelShortNSN:
+		return "short_nsn_match"
+	case MatchLevelNSN:
+		return "nsn_match"
+	case MatchLevelExact:
+		return "exact_match"
+	}
+	return "no_match"
+}
+
+// Match function for getting how closely phone numbers first and second match, country is used for numbers
+// without + sign like in ParsePhone. Numbers of any type are compared, a number ParsePhone rejects is compared
+// by its digits without calling code or national prefix
+func Match(first, second, country string) MatchLevel {
+	first, firstExtension := splitExtension(first)
+	second, secondExtension := splitExtension(second)
+	if firstExtension != "" && secondExtension != "" && firstExtension != secondExtension {
+		return MatchLevelNone
+	}
+	sameExtension := firstExtension == secondExtension
+
+	firstNumber, firstErr := ParsePhone(first, country, AllowTypes(NumberTypeUnknown))
+	secondNumber, secondErr := ParsePhone(second, country, AllowTypes(NumberTypeUnknown))
+	switch {
+	case firstErr == nil && secondErr == nil:
+		if firstNumber.CountryCode != secondNumber.CountryCode {
+			return MatchLevelNone
+		}
+		return matchNationalNumbers(firstNumber.NationalNumber, secondNumber.NationalNumber, sameExtension, MatchLevelExact)
+	case firstErr == nil:
+		return matchNationalNumbers(firstNumber.NationalNumber, unparsedNationalNumber(second, firstNumber), sameExtension, MatchLevelNSN)
+	case secondErr == nil:
+		return matchNationalNumbers(unparsedNationalNumber(first, secondNumber), secondNumber.NationalNumber, sameExtension, MatchLevelNSN)
+	}
+	return matchNationalNumbers(digitsOnly(first), digitsOnly(second), sameExtension, MatchLevelNSN)
+}
+
+// matchNationalNumbers function for getting the match level of national numbers first and second,
+// level is returned when both numbers and their extensions are the same. A number ending the other one
+// is a short match when it has at least minShortMatchDigits digits
+func matchNationalNumbers(first, second string, sameExtension bool, level MatchLevel) MatchLevel {
+	if first == "" || second == "" {
+		return MatchLevelNone
+	}
+	if first == second && sameExtension {
+		return level
+	}
+	if len(first) < minShortMatchDigits || len(second) < minShortMatchDigits {
+		return MatchLevelNone
+	}
+	if strings.HasSuffix(first, second) || strings.HasSuffix(second, first) {
+		return MatchLevelShortNSN
+	}
+	return MatchLevelNone
+}
+
+// unparsedNationalNumber function for getting the digits of a number ParsePhone rejects without the calling code
+// or national prefix of parsed, the number it is compared with. A number with + sign and another calling code
+// than parsed has no national number to compare
+func unparsedNationalNumber(number string, parsed PhoneNumber) string {
+	digits := digitsOnly(number)
+	if strings.HasPrefix(strings.TrimSpace(number), "+") {
+		digits = strings.TrimLeft(digits, "0")
+		if !strings.HasPrefix(digits, parsed.CountryCode) {
+			return ""
+		}
+		return digits[len(parsed.CountryCode):]
+	}
+	if digits == parsed.String() {
+		return parsed.NationalNumber
+	}
+	prefix := parsed.Country.NationalPrefix
+	if prefix != "" && strings.HasPrefix(digits, prefix) {
+		return digits[len(prefix):]
+	}
+	return digits
+}
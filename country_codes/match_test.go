@@ -0,0 +1,46 @@
+package countrycodes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	testCases := []struct {
+		name, first, second, country string
+		expected                     MatchLevel
+	}{
+		{name: "Testcase #1: national and international", first: "0812-3456-7890", second: "+62 812 3456 7890", country: "ID", expected: MatchLevelExact},
+		{name: "Testcase #2: calling code without + sign", first: "6281234567890", second: "+62 812 3456 7890", country: "ID", expected: MatchLevelExact},
+		{name: "Testcase #3: national and calling code without + sign", first: "0812-3456-7890", second: "6281234567890", country: "ID", expected: MatchLevelExact},
+		{name: "Testcase #4: unknown calling code", first: "6281234567890", second: "+62 812 3456 7890", country: "", expected: MatchLevelNSN},
+		{name: "Testcase #5: unknown national prefix", first: "0812 3456 7890", second: "+62 812 3456 7890", country: "", expected: MatchLevelNSN},
+		{name: "Testcase #6: neither number parsed", first: "0812 3456 7890", second: "0812-3456-7890", country: "", expected: MatchLevelNSN},
+		{name: "Testcase #7: local number without area code", first: "+62 21 555 1234", second: "555 1234", country: "ID", expected: MatchLevelShortNSN},
+		{name: "Testcase #8: same extension", first: "+62 21 555 1234 ext. 12", second: "(021) 555-1234 x12", country: "ID", expected: MatchLevelExact},
+		{name: "Testcase #9: extension on one side only", first: "+62 21 555 1234 ext. 12", second: "+62 21 555 1234", country: "ID", expected: MatchLevelShortNSN},
+		{name: "Testcase #10: Negative, different extensions", first: "+62 21 555 1234;ext=12", second: "+62 21 555 1234 #13", country: "ID", expected: MatchLevelNone},
+		{name: "Testcase #11: Negative, different calling codes", first: "+1 201 555 0123", second: "+44 20 1555 0123", country: "", expected: MatchLevelNone},
+		{name: "Testcase #12: Negative, different numbers", first: "+62 812 3456 7890", second: "+62 812 3456 7891", country: "ID", expected: MatchLevelNone},
+		{name: "Testcase #13: Negative, empty number", first: "", second: "+62 812 3456 7890", country: "ID", expected: MatchLevelNone},
+		{name: "Testcase #14: Negative, too short suffix", first: "0812-3456-7890", second: "7890", country: "ID", expected: MatchLevelNone},
+		{name: "Testcase #15: Negative, unparsed number of another calling code", first: "+62 812 3456 7890", second: "+1 812 3456 7890", country: "ID", expected: MatchLevelNone},
+		{name: "Testcase #16: Negative, too short suffix of unparsed numbers", first: "12 3456", second: "3456", country: "", expected: MatchLevelNone},
+		{name: "Testcase #17: unparsed number of the same calling code", first: "+62 812 3456 7890", second: "+62 12 3456 7890", country: "ID", expected: MatchLevelShortNSN},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Match(tc.first, tc.second, tc.country))
+			assert.Equal(t, tc.expected, Match(tc.second, tc.first, tc.country))
+		})
+	}
+}
+
+func TestMatchLevelString(t *testing.T) {
+	assert.Equal(t, "exact_match", MatchLevelExact.String())
+	assert.Equal(t, "nsn_match", MatchLevelNSN.String())
+	assert.Equal(t, "short_nsn_match", MatchLevelShortNSN.String())
+	assert.Equal(t, "no_match", MatchLevelNone.String())
+}
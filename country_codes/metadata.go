@@ -0,0 +1,476 @@
+package countrycodes
+
+import (
+	"bytes"
+	_ "embed" // embedded metadata file
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+//go:generate go run ./cmd/genmetadata -o metadata.json
+
+// embeddedMetadata default metadata, generated by cmd/genmetadata
+//
+//go:embed metadata.json
+var embeddedMetadata []byte
+
+// metadataVersion version of the metadata file format
+const metadataVersion = 2
+
+var (
+	// ErrMetadataVersion variable for error of unsupported metadata file version
+	ErrMetadataVersion = errors.New("unsupported metadata version")
+	// ErrNoCountries variable for error of metadata without any country
+	ErrNoCountries = errors.New("metadata has no country")
+	// ErrInvalidAlpha2 variable for error of alpha2 not made of 2 uppercase letters
+	ErrInvalidAlpha2 = errors.New("invalid alpha2")
+	// ErrInvalidAlpha3 variable for error of alpha3 not made of 3 uppercase letters
+	ErrInvalidAlpha3 = errors.New("invalid alpha3")
+	// ErrDuplicateAlpha2 variable for error of alpha2 used by more than one country
+	ErrDuplicateAlpha2 = errors.New("duplicate alpha2")
+	// ErrDuplicateAlpha3 variable for error of alpha3 used by more than one country
+	ErrDuplicateAlpha3 = errors.New("duplicate alpha3")
+	// ErrInvalidNumeric variable for error of numeric code not made of 3 digits
+	ErrInvalidNumeric = errors.New("invalid numeric code")
+	// ErrDuplicateNumeric variable for error of numeric code used by more than one country
+	ErrDuplicateNumeric = errors.New("duplicate numeric code")
+	// ErrInvalidSubdivision variable for error of subdivision code not starting with the country alpha2
+	// or parent not being a subdivision of the country
+	ErrInvalidSubdivision = errors.New("invalid subdivision")
+	// ErrDuplicateSubdivision variable for error of subdivision code used more than once
+	ErrDuplicateSubdivision = errors.New("duplicate subdivision")
+	// ErrInvalidLocalizedName variable for error of localized name with empty name or
+	// a language not made of 2 or 3 lowercase letters
+	ErrInvalidLocalizedName = errors.New("invalid localized name")
+	// ErrNoDefaultPhone variable for error of first country, the default of ParsePhone, without phone metadata
+	ErrNoDefaultPhone = errors.New("default country has no phone metadata")
+	// ErrInvalidCallingCode variable for error of calling code not made of 1 to 3 digits
+	ErrInvalidCallingCode = errors.New("invalid calling code")
+	// ErrEmptyLengths variable for error of country without phone number length
+	ErrEmptyLengths = errors.New("empty phone number lengths")
+	// ErrInvalidLengths variable for error of phone number length lower than 1
+	ErrInvalidLengths = errors.New("invalid phone number lengths")
+	// ErrInvalidPrefix variable for error of number type or dialing prefix with non digit character
+	ErrInvalidPrefix = errors.New("invalid number prefix")
+	// ErrInvalidPattern variable for error of number format pattern not compiling
+	ErrInvalidPattern = errors.New("invalid number format pattern")
+	// ErrDuplicateMainCountry variable for error of calling code with more than one main country
+	ErrDuplicateMainCountry = errors.New("duplicate main country for calling code")
+)
+
+// MetadataError data structure of an invalid metadata entry, Err is one of the metadata sentinel errors
+type MetadataError struct {
+	Index  int
+	Alpha2 string
+	Err    error
+}
+
+// Error method for getting the error message
+func (e *MetadataError) Error() string {
+	return fmt.Sprintf("metadata entry %d (%s): %v", e.Index, e.Alpha2, e.Err)
+}
+
+// Unwrap method for getting the sentinel error
+func (e *MetadataError) Unwrap() error {
+	return e.Err
+}
+
+// MetadataErrors list of every invalid metadata entry
+type MetadataErrors []*MetadataError
+
+// Error method for getting the messages of every error
+func (e MetadataErrors) Error() string {
+	messages := make([]string, len(e))
+	for k, err := range e {
+		messages[k] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Is method for matching any of the errors against target
+func (e MetadataErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// metadataFile data structure of the metadata file
+type metadataFile struct {
+	Version   int               `json:"version"`
+	Countries []metadataCountry `json:"countries"`
+}
+
+// metadataCountry data structure of a country in the metadata file
+type metadataCountry struct {
+	Alpha2           string                `json:"alpha2"`
+	Alpha3           string                `json:"alpha3"`
+	Numeric          string                `json:"numeric"`
+	Name             string                `json:"name"`
+	OfficialName     string                `json:"official_name,omitempty"`
+	CommonName       string                `json:"common_name,omitempty"`
+	AlternativeNames []string              `json:"alternative_names,omitempty"`
+	LocalizedNames   map[string]string     `json:"localized_names,omitempty"`
+	Region           string                `json:"region,omitempty"`
+	SubRegion        string                `json:"sub_region,omitempty"`
+	Currencies       []string              `json:"currencies,omitempty"`
+	TLD              string                `json:"tld,omitempty"`
+	Capital          string                `json:"capital,omitempty"`
+	Subdivisions     []metadataSubdivision `json:"subdivisions,omitempty"`
+	Phone            *metadataPhone        `json:"phone,omitempty"`
+}
+
+// metadataSubdivision data structure of a subdivision in the metadata file
+type metadataSubdivision struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Parent string `json:"parent,omitempty"`
+}
+
+// metadataPhone data structure of the phone dialing data of a country in the metadata file
+type metadataPhone struct {
+	CountryCode           string           `json:"country_code"`
+	MainCountryForCode    bool             `json:"main_country_for_code,omitempty"`
+	VanityNumbers         bool             `json:"vanity_numbers,omitempty"`
+	NationalPrefix        string           `json:"national_prefix,omitempty"`
+	InternationalPrefixes []string         `json:"international_prefixes,omitempty"`
+	PhoneNumberLengths    []int            `json:"phone_number_lengths"`
+	MobileBeginWith       []string         `json:"mobile_begin_with"`
+	FixedLineBeginWith    []string         `json:"fixed_line_begin_with,omitempty"`
+	TollFreeBeginWith     []string         `json:"toll_free_begin_with,omitempty"`
+	PremiumRateBeginWith  []string         `json:"premium_rate_begin_with,omitempty"`
+	SharedCostBeginWith   []string         `json:"shared_cost_begin_with,omitempty"`
+	VoIPBeginWith         []string         `json:"voip_begin_with,omitempty"`
+	NumberFormats         []metadataFormat `json:"number_formats,omitempty"`
+}
+
+// metadataFormat data structure of a number format in the metadata file
+type metadataFormat struct {
+	Pattern             string `json:"pattern"`
+	Format              string `json:"format"`
+	InternationalFormat string `json:"international_format,omitempty"`
+	LeadingDigits       string `json:"leading_digits,omitempty"`
+}
+
+// ReadMetadata function for decoding and validating countries from a metadata file,
+// validation failures are returned as MetadataErrors
+func ReadMetadata(r io.Reader) ([]Country, error) {
+	var file metadataFile
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		return nil, err
+	}
+	if file.Version != metadataVersion {
+		return nil, ErrMetadataVersion
+	}
+
+	countries := make([]Country, len(file.Countries))
+	for k, c := range file.Countries {
+		countries[k] = Country{
+			Alpha2:           c.Alpha2,
+			Alpha3:           c.Alpha3,
+			Numeric:          c.Numeric,
+			Name:             c.Name,
+			OfficialName:     c.OfficialName,
+			CommonName:       c.CommonName,
+			AlternativeNames: c.AlternativeNames,
+			LocalizedNames:   c.LocalizedNames,
+			Region:           c.Region,
+			SubRegion:        c.SubRegion,
+			Currencies:       c.Currencies,
+			TLD:              c.TLD,
+			Capital:          c.Capital,
+		}
+		for _, s := range c.Subdivisions {
+			countries[k].Subdivisions = append(countries[k].Subdivisions, Subdivision(s))
+		}
+		if p := c.Phone; p != nil {
+			phone := &PhoneMetadata{
+				CountryCode:           p.CountryCode,
+				MobileBeginWith:       nonNilStrings(p.MobileBeginWith),
+				PhoneNumberLengths:    p.PhoneNumberLengths,
+				NationalPrefix:        p.NationalPrefix,
+				InternationalPrefixes: p.InternationalPrefixes,
+				FixedLineBeginWith:    p.FixedLineBeginWith,
+				TollFreeBeginWith:     p.TollFreeBeginWith,
+				PremiumRateBeginWith:  p.PremiumRateBeginWith,
+				SharedCostBeginWith:   p.SharedCostBeginWith,
+				VoIPBeginWith:         p.VoIPBeginWith,
+				MainCountryForCode:    p.MainCountryForCode,
+				VanityNumbers:         p.VanityNumbers,
+			}
+			for _, f := range p.NumberFormats {
+				phone.NumberFormats = append(phone.NumberFormats, NumberFormat(f))
+			}
+			countries[k].Phone = phone
+		}
+	}
+
+	if err := validateMetadata(countries); err != nil {
+		return nil, err
+	}
+	return countries, nil
+}
+
+// WriteMetadata function for encoding countries into a metadata file, one country per line
+func WriteMetadata(w io.Writer, countries []Country) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "{\"version\":%d,\"countries\":[\n", metadataVersion)
+	for k, i := range countries {
+		c := metadataCountry{
+			Alpha2:           i.Alpha2,
+			Alpha3:           i.Alpha3,
+			Numeric:          i.Numeric,
+			Name:             i.Name,
+			OfficialName:     i.OfficialName,
+			CommonName:       i.CommonName,
+			AlternativeNames: i.AlternativeNames,
+			LocalizedNames:   i.LocalizedNames,
+			Region:           i.Region,
+			SubRegion:        i.SubRegion,
+			Currencies:       i.Currencies,
+			TLD:              i.TLD,
+			Capital:          i.Capital,
+		}
+		for _, s := range i.Subdivisions {
+			c.Subdivisions = append(c.Subdivisions, metadataSubdivision(s))
+		}
+		if p := i.Phone; p != nil {
+			c.Phone = &metadataPhone{
+				CountryCode:           p.CountryCode,
+				MainCountryForCode:    p.MainCountryForCode,
+				VanityNumbers:         p.VanityNumbers,
+				NationalPrefix:        p.NationalPrefix,
+				InternationalPrefixes: p.InternationalPrefixes,
+				PhoneNumberLengths:    p.PhoneNumberLengths,
+				MobileBeginWith:       nonNilStrings(p.MobileBeginWith),
+				FixedLineBeginWith:    p.FixedLineBeginWith,
+				TollFreeBeginWith:     p.TollFreeBeginWith,
+				PremiumRateBeginWith:  p.PremiumRateBeginWith,
+				SharedCostBeginWith:   p.SharedCostBeginWith,
+				VoIPBeginWith:         p.VoIPBeginWith,
+			}
+			for _, f := range p.NumberFormats {
+				c.Phone.NumberFormats = append(c.Phone.NumberFormats, metadataFormat(f))
+			}
+		}
+
+		line, err := json.Marshal(c)
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		if k < len(countries)-1 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("]}\n")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// LoadMetadata function for replacing the metadata used by every function of the package
+// with a metadata file, the current metadata is kept when r is invalid
+func LoadMetadata(r io.Reader) error {
+	countries, err := ReadMetadata(r)
+	if err != nil {
+		return err
+	}
+	reg, err := newRegistry(countries)
+	if err != nil {
+		return err
+	}
+	setRegistry(reg)
+	return nil
+}
+
+// LoadMetadataFile function for replacing the metadata with the metadata file at path
+func LoadMetadataFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return LoadMetadata(f)
+}
+
+// ResetMetadata function for restoring the embedded metadata
+func ResetMetadata() error {
+	reg, err := embeddedRegistry()
+	if err != nil {
+		return err
+	}
+	setRegistry(reg)
+	return nil
+}
+
+// embeddedRegistry function for building the registry of the embedded metadata
+func embeddedRegistry() (*registry, error) {
+	countries, err := ReadMetadata(bytes.NewReader(embeddedMetadata))
+	if err != nil {
+		return nil, err
+	}
+	return newRegistry(countries)
+}
+
+// validateMetadata function for checking every country, the first country is the default of ParsePhone
+func validateMetadata(countries []Country) error {
+	if len(countries) == 0 {
+		return ErrNoCountries
+	}
+
+	var errs MetadataErrors
+	alpha2s := make(map[string]bool, len(countries))
+	alpha3s := make(map[string]bool, len(countries))
+	numerics := make(map[string]bool, len(countries))
+	mainCountries := make(map[string]bool)
+	for k, c := range countries {
+		fail := func(err error) {
+			errs = append(errs, &MetadataError{Index: k, Alpha2: c.Alpha2, Err: err})
+		}
+
+		if !upperLetters.MatchString(c.Alpha2) || len(c.Alpha2) != 2 {
+			fail(ErrInvalidAlpha2)
+		} else if alpha2s[c.Alpha2] {
+			fail(ErrDuplicateAlpha2)
+		}
+		alpha2s[c.Alpha2] = true
+
+		if !upperLetters.MatchString(c.Alpha3) || len(c.Alpha3) != 3 {
+			fail(ErrInvalidAlpha3)
+		} else if alpha3s[c.Alpha3] {
+			fail(ErrDuplicateAlpha3)
+		}
+		alpha3s[c.Alpha3] = true
+
+		if len(c.Numeric) != 3 || digitsOnly(c.Numeric) != c.Numeric {
+			fail(ErrInvalidNumeric)
+		} else if numerics[c.Numeric] {
+			fail(ErrDuplicateNumeric)
+		}
+		numerics[c.Numeric] = true
+
+		for language, name := range c.LocalizedNames {
+			if !lowerLetters.MatchString(language) || strings.TrimSpace(name) == "" {
+				fail(ErrInvalidLocalizedName)
+				break
+			}
+		}
+
+		if err := validateSubdivisions(c); err != nil {
+			fail(err)
+		}
+
+		if c.Phone == nil {
+			if k == 0 {
+				fail(ErrNoDefaultPhone)
+			}
+			continue
+		}
+		i := c.Phone
+
+		if len(i.CountryCode) < 1 || len(i.CountryCode) > 3 || digitsOnly(i.CountryCode) != i.CountryCode {
+			fail(ErrInvalidCallingCode)
+		}
+
+		if len(i.PhoneNumberLengths) == 0 {
+			fail(ErrEmptyLengths)
+		}
+		for _, l := range i.PhoneNumberLengths {
+			if l < 1 {
+				fail(ErrInvalidLengths)
+				break
+			}
+		}
+
+		if !validPrefixes(append([]string{i.NationalPrefix}, i.InternationalPrefixes...)) {
+			fail(ErrInvalidPrefix)
+		}
+		for _, t := range numberTypes {
+			if !validPrefixes(i.BeginWith(t)) {
+				fail(ErrInvalidPrefix)
+				break
+			}
+		}
+
+		for _, f := range i.NumberFormats {
+			if !validFormat(f) {
+				fail(ErrInvalidPattern)
+				break
+			}
+		}
+
+		if i.MainCountryForCode {
+			if mainCountries[i.CountryCode] {
+				fail(ErrDuplicateMainCountry)
+			}
+			mainCountries[i.CountryCode] = true
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateSubdivisions function for checking the subdivision codes and parents of country c
+func validateSubdivisions(c Country) error {
+	codes := make(map[string]bool, len(c.Subdivisions))
+	for _, s := range c.Subdivisions {
+		if !strings.HasPrefix(s.Code, c.Alpha2+"-") || len(s.Code) == len(c.Alpha2)+1 {
+			return ErrInvalidSubdivision
+		}
+		if codes[s.Code] {
+			return ErrDuplicateSubdivision
+		}
+		codes[s.Code] = true
+	}
+	for _, s := range c.Subdivisions {
+		if s.Parent != "" && !codes[s.Parent] {
+			return ErrInvalidSubdivision
+		}
+	}
+	return nil
+}
+
+var (
+	upperLetters = regexp.MustCompile(`^[A-Z]+$`)
+	lowerLetters = regexp.MustCompile(`^[a-z]{2,3}$`)
+)
+
+func validFormat(f NumberFormat) bool {
+	for _, expr := range formatExprs(f) {
+		if _, err := regexp.Compile(expr); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func validPrefixes(prefixes []string) bool {
+	for _, w := range prefixes {
+		if digitsOnly(w) != w {
+			return false
+		}
+	}
+	return true
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
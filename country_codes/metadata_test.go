@@ -0,0 +1,119 @@
+package countrycodes
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadWriteMetadata(t *testing.T) {
+	countries, err := ReadMetadata(bytes.NewReader(embeddedMetadata))
+	assert.NoError(t, err)
+	assert.Len(t, countries, 249)
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteMetadata(&buf, countries))
+	assert.Equal(t, string(embeddedMetadata), buf.String())
+}
+
+func TestReadMetadataValidation(t *testing.T) {
+	const (
+		us = `{"alpha2":"US","alpha3":"USA","numeric":"840","name":"United States",` +
+			`"phone":{"country_code":"1","phone_number_lengths":[10],"mobile_begin_with":["2"]}}`
+		aq = `{"alpha2":"AQ","alpha3":"ATA","numeric":"010","name":"Antarctica"}`
+		sg = `{"alpha2":"SG","alpha3":"SGP","numeric":"702","name":"Singapore","subdivisions":[` +
+			`{"code":"SG-01","name":"Central Singapore","type":"District"}]}`
+	)
+
+	testCases := []struct {
+		name, metadata string
+		err            error
+	}{
+		{name: "Testcase #1: Positive", metadata: `{"version":2,"countries":[` + us + `]}`},
+		{name: "Testcase #2: Negative, version", metadata: `{"version":1,"countries":[` + us + `]}`, err: ErrMetadataVersion},
+		{name: "Testcase #3: Negative, no country", metadata: `{"version":2,"countries":[]}`, err: ErrNoCountries},
+		{name: "Testcase #4: Negative, duplicate alpha2", metadata: `{"version":2,"countries":[` + us + `,` +
+			strings.Replace(us, `"USA"`, `"USB"`, 1) + `]}`, err: ErrDuplicateAlpha2},
+		{name: "Testcase #5: Negative, duplicate alpha3", metadata: `{"version":2,"countries":[` + us + `,` +
+			strings.Replace(us, `"US"`, `"UM"`, 1) + `]}`, err: ErrDuplicateAlpha3},
+		{name: "Testcase #6: Negative, empty lengths", metadata: `{"version":2,"countries":[` +
+			strings.Replace(us, `[10]`, `[]`, 1) + `]}`, err: ErrEmptyLengths},
+		{name: "Testcase #7: Negative, invalid prefix", metadata: `{"version":2,"countries":[` +
+			strings.Replace(us, `["2"]`, `["2x"]`, 1) + `]}`, err: ErrInvalidPrefix},
+		{name: "Testcase #8: Negative, invalid calling code", metadata: `{"version":2,"countries":[` +
+			strings.Replace(us, `"1"`, `"+1"`, 1) + `]}`, err: ErrInvalidCallingCode},
+		{name: "Testcase #9: Negative, invalid pattern", metadata: `{"version":2,"countries":[` +
+			strings.Replace(us, `]}}`, `],"number_formats":[{"pattern":"(","format":"$1"}]}}`, 1) + `]}`, err: ErrInvalidPattern},
+		{name: "Testcase #10: Positive, countries without phone metadata", metadata: `{"version":2,"countries":[` +
+			us + `,` + aq + `,` + sg + `]}`},
+		{name: "Testcase #11: Negative, default country without phone metadata", metadata: `{"version":2,"countries":[` +
+			aq + `,` + us + `]}`, err: ErrNoDefaultPhone},
+		{name: "Testcase #12: Negative, invalid numeric", metadata: `{"version":2,"countries":[` + us + `,` +
+			strings.Replace(aq, `"010"`, `"10"`, 1) + `]}`, err: ErrInvalidNumeric},
+		{name: "Testcase #13: Negative, duplicate numeric", metadata: `{"version":2,"countries":[` + us + `,` +
+			strings.Replace(aq, `"010"`, `"840"`, 1) + `]}`, err: ErrDuplicateNumeric},
+		{name: "Testcase #14: Negative, subdivision of another country", metadata: `{"version":2,"countries":[` + us + `,` +
+			strings.Replace(sg, `"SG-01"`, `"MY-01"`, 1) + `]}`, err: ErrInvalidSubdivision},
+		{name: "Testcase #15: Negative, unknown subdivision parent", metadata: `{"version":2,"countries":[` + us + `,` +
+			strings.Replace(sg, `"District"`, `"District","parent":"SG-02"`, 1) + `]}`, err: ErrInvalidSubdivision},
+		{name: "Testcase #16: Negative, duplicate subdivision", metadata: `{"version":2,"countries":[` + us + `,` +
+			strings.Replace(sg, `}]}`, `},{"code":"SG-01","name":"Central","type":"District"}]}`, 1) + `]}`, err: ErrDuplicateSubdivision},
+		{name: "Testcase #17: Negative, invalid localized name language", metadata: `{"version":2,"countries":[` +
+			strings.Replace(us, `"name":"United States"`, `"name":"United States","localized_names":{"ID":"Amerika Serikat"}`, 1) + `]}`,
+			err: ErrInvalidLocalizedName},
+		{name: "Testcase #18: Negative, empty localized name", metadata: `{"version":2,"countries":[` +
+			strings.Replace(us, `"name":"United States"`, `"name":"United States","localized_names":{"id":" "}`, 1) + `]}`,
+			err: ErrInvalidLocalizedName},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			countries, err := ReadMetadata(strings.NewReader(tc.metadata))
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "got %v", err)
+				assert.Nil(t, countries)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotEmpty(t, countries)
+		})
+	}
+
+	_, err := ReadMetadata(strings.NewReader(`{"version":2,"countries":[{"alpha2":"US","unknown":true}]}`))
+	assert.Error(t, err)
+
+	var metadataErrs MetadataErrors
+	_, err = ReadMetadata(strings.NewReader(`{"version":2,"countries":[` + us + `,` + us + `]}`))
+	assert.True(t, errors.As(err, &metadataErrs))
+	assert.Len(t, metadataErrs, 3)
+	assert.Equal(t, 1, metadataErrs[0].Index)
+	assert.Equal(t, "metadata entry 1 (US): duplicate alpha2", metadataErrs[0].Error())
+}
+
+func TestLoadMetadataFile(t *testing.T) {
+	defer ResetMetadata()
+
+	path := filepath.Join(t.TempDir(), "metadata.json")
+	override := `{"version":2,"countries":[{"alpha2":"ID","alpha3":"IDN","numeric":"360","name":"Indonesia",` +
+		`"phone":{"country_code":"62","phone_number_lengths":[9,10,11,12],"mobile_begin_with":["8"]}}]}`
+	assert.NoError(t, os.WriteFile(path, []byte(override), 0644))
+
+	assert.Equal(t, "6281234567890", Parse("081234567890", "ID"))
+	assert.NoError(t, LoadMetadataFile(path))
+	assert.Len(t, GetISO3166(), 1)
+	assert.Equal(t, "", Parse("0512345678", "ID", AllowTypes(NumberTypeFixedLine)))
+	assert.Equal(t, "ID", GetISO3166()[0].Alpha2)
+
+	// invalid file keeps the loaded metadata
+	assert.Error(t, LoadMetadata(strings.NewReader(`{"version":2,"countries":[]}`)))
+	assert.Error(t, LoadMetadataFile(filepath.Join(t.TempDir(), "missing.json")))
+	assert.Len(t, GetISO3166(), 1)
+
+	assert.NoError(t, ResetMetadata())
+	assert.Len(t, GetISO3166(), 227)
+}
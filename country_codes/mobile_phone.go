@@ -1,122 +1,174 @@
 package countrycodes
 
 import (
-	"regexp"
 	"strings"
 )
 
 // Parse function for parsing phone number
 // number string phone number
 // country string country name
-func Parse(number string, country string) string {
-	number = strings.Replace(number, " ", "", -1)
+// opts ParseOption such as AllowTypes, mobile numbers only by default
+func Parse(number string, country string, opts ...ParseOption) string {
+	phoneNumber, err := ParsePhone(number, country, opts...)
+	if err != nil {
+		return ""
+	}
+	return phoneNumber.String()
+}
+
+// ParsePhone function for parsing phone number into its country, calling code and national number,
+// country is used when number has no + sign and may be alpha2, alpha3 or country name,
+// an empty country means United States. Only mobile numbers are accepted unless AllowTypes is given.
+// The national prefix and international prefixes of country are dialing data, see PhoneMetadata,
+// and leading zeros of a national number, or of the own calling code, are ignored when the number is only accepted without them.
+// Zeros after the + sign, such as +0062 or +00 62, are ignored as no calling code starts with 0.
+// An extension written as ext. 12, x12, #12 or ;ext=12 is kept in PhoneNumber.Extension and the letters of
+// a vanity number such as +1 800 FLOWERS are keypad digits when the country allows vanity numbers,
+// letters that do not make a valid vanity number such as a (home) label are left out like other characters.
+// A failure is returned as *ParseError
+func ParsePhone(number string, country string, opts ...ParseOption) (PhoneNumber, error) {
+	options := newParseOptions(opts)
 	country = strings.Replace(country, " ", "", -1)
-	plusSign := false
-	if strings.HasPrefix(number, "+") {
-		plusSign = true
+	withoutExtension, extension := splitExtension(number)
+
+	var vanityErr error
+	if digits, ok := vanityDigits(withoutExtension); ok {
+		phoneNumber, err := parsePhoneDigits(digits, country, options, true)
+		if err == nil {
+			return phoneNumber.withInput(number, extension), nil
+		}
+		vanityErr = err
 	}
 
+	phoneNumber, err := parsePhoneDigits(withoutExtension, country, options, false)
+	if err != nil {
+		// a vanity number is not reported as a wrong length of its digits without letters
+		if vanityErr != nil {
+			err = vanityErr
+		}
+		return PhoneNumber{}, &ParseError{Number: number, Country: country, Err: err}
+	}
+	return phoneNumber.withInput(number, extension), nil
+}
+
+// withInput method for setting the raw input and extension of a parsed phone number
+func (p PhoneNumber) withInput(rawInput, extension string) PhoneNumber {
+	p.RawInput = rawInput
+	p.Extension = extension
+	return p
+}
+
+// parsePhoneDigits function for parsing the digits of number without extension, the error is a sentinel error.
+// The number must be valid before a vanity number is checked against the country
+func parsePhoneDigits(number string, country string, options *parseOptions, vanity bool) (PhoneNumber, error) {
+	plusSign := strings.HasPrefix(strings.TrimSpace(number), "+")
+
 	// remove any non-digit character, included the +
-	number = regexp.MustCompile(`\D`).ReplaceAllString(number, "")
+	number = digitsOnly(number)
+	if number == "" {
+		return PhoneNumber{}, ErrEmptyNumber
+	}
 
 	iso3166 := getISO3166ByCountry(country)
 
-	if indexOfString(iso3166.Alpha3, []string{"GAB", "CIV", "COG"}) == -1 {
-		r := regexp.MustCompile(`^0+`)
-		number = r.ReplaceAllString(number, "")
-	}
-	r := regexp.MustCompile(`^89`)
-	if iso3166.Alpha3 == "RUS" && len(number) == 11 && r.MatchString(number) == true {
-		r := regexp.MustCompile(`^8+`)
-		number = r.ReplaceAllString(number, "")
+	// an international prefix such as 00 or 011 dialed in place of the + sign
+	if !plusSign && iso3166.Alpha2 != "" {
+		number, plusSign = stripInternationalPrefix(number, iso3166, options)
 	}
 	if plusSign {
-		iso3166 = getISO3166ByNumber(number)
+		// no calling code starts with 0, zeros after the + sign are a mistyped 00 international prefix
+		number = strings.TrimLeft(number, "0")
+		iso3166 = getISO3166ByNumber(number, options)
+		if iso3166.Alpha2 == "" {
+			return PhoneNumber{}, diagnoseNumber(number, options)
+		}
 	} else {
+		if iso3166.Alpha2 == "" {
+			return PhoneNumber{}, ErrUnknownCountry
+		}
+		number = stripNationalPrefix(number, iso3166)
+		number = stripLeadingZeros(number, iso3166, options)
 		if indexOfInt(len(number), iso3166.PhoneNumberLengths) != -1 {
 			number = iso3166.CountryCode + number
 		}
 	}
-	if validatePhoneISO3166(number, iso3166) {
-		return number
+
+	nationalNumber, numberType, err := validatePhoneISO3166(number, iso3166, options)
+	if err != nil {
+		return PhoneNumber{}, err
+	}
+	if vanity && !iso3166.VanityNumbers {
+		return PhoneNumber{}, ErrVanityNotAllowed
 	}
-	return ""
+
+	return PhoneNumber{
+		Country:        iso3166.clone(),
+		CountryCode:    iso3166.CountryCode,
+		NationalNumber: nationalNumber,
+		Type:           numberType,
+	}, nil
 }
 
 func getISO3166ByCountry(country string) ISO3166 {
-	iso3166 := ISO3166{}
-	uppperCaseCountry := strings.ToUpper(country)
-	switch len(country) {
-	case 0:
-		iso3166 = GetISO3166()[0]
-		break
-	case 2:
-		for _, i := range GetISO3166() {
-			if i.Alpha2 == uppperCaseCountry {
-				iso3166 = i
-				break
-			}
-		}
-		break
-	case 3:
-		for _, i := range GetISO3166() {
-			if i.Alpha3 == uppperCaseCountry {
-				iso3166 = i
-				break
-			}
-		}
-		break
-	default:
-		for _, i := range GetISO3166() {
-			if strings.ToUpper(i.CountryName) == uppperCaseCountry {
-				iso3166 = i
-				break
-			}
-		}
-		break
-	}
+	iso3166, _ := getRegistry().byCountry(country)
 	return iso3166
 }
 
-func getISO3166ByNumber(number string) ISO3166 {
-	iso3166 := ISO3166{}
-	for _, i := range GetISO3166() {
-		r := regexp.MustCompile(`^` + i.CountryCode)
-		for _, l := range i.PhoneNumberLengths {
-			if r.MatchString(number) && len(number) == len(i.CountryCode)+l {
-				for _, w := range i.MobileBeginWith {
-					r := regexp.MustCompile(`^` + i.CountryCode + w)
-					if r.MatchString(number) {
-						iso3166 = i
-						break
-					}
-				}
-			}
-		}
+// getISO3166ByNumber function for getting the country of an international number of an accepted type,
+// see resolveRegions for the order of countries sharing a calling code
+func getISO3166ByNumber(number string, options *parseOptions) ISO3166 {
+	regions := resolveRegions(number, options)
+	if len(regions) == 0 {
+		return ISO3166{}
 	}
-	return iso3166
+	return regions[0]
 }
 
-func validatePhoneISO3166(number string, iso3166 ISO3166) bool {
-	r := regexp.MustCompile(`^` + iso3166.CountryCode)
-	number = r.ReplaceAllString(number, "")
+// validatePhoneISO3166 function for validating number against iso3166 lengths and accepted type prefixes,
+// the national number without country calling code and its type are returned
+func validatePhoneISO3166(number string, iso3166 ISO3166, options *parseOptions) (string, NumberType, error) {
+	number = strings.TrimPrefix(number, iso3166.CountryCode)
 	if len(iso3166.PhoneNumberLengths) == 0 {
-		return false
+		return "", NumberTypeUnknown, ErrInvalidLength
+	}
+	if indexOfInt(len(number), iso3166.PhoneNumberLengths) == -1 {
+		return "", NumberTypeUnknown, ErrInvalidLength
 	}
-	for _, l := range iso3166.PhoneNumberLengths {
-		if l == len(number) {
-			for _, w := range iso3166.MobileBeginWith {
-				r := regexp.MustCompile(`^` + w)
-				if r.MatchString(number) == true {
-					return true
-				}
+	numberType, ok := options.resolveType(number, iso3166)
+	if !ok {
+		return "", NumberTypeUnknown, options.typeError()
+	}
+	return number, numberType, nil
+}
+
+// diagnoseNumber function for getting the reason no country matches an international number
+func diagnoseNumber(number string, options *parseOptions) error {
+	reg := getRegistry()
+	err := ErrUnknownCountry
+	for _, k := range reg.candidates(number) {
+		i := reg.countries[k]
+		if indexOfInt(len(number)-len(i.CountryCode), i.PhoneNumberLengths) == -1 {
+			if err == ErrUnknownCountry {
+				err = ErrInvalidLength
 			}
+			continue
 		}
+		err = options.typeError()
 	}
-	return false
+	return err
 }
 
-func indexOfString(word string, data []string) int {
+// digitsOnly function for removing any non ASCII digit character
+func digitsOnly(number string) string {
+	return strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return -1
+		}
+		return r
+	}, number)
+}
+
+func indexOfInt(word int, data []int) int {
 	for k, v := range data {
 		if word == v {
 			return k
@@ -125,7 +177,7 @@ func indexOfString(word string, data []string) int {
 	return -1
 }
 
-func indexOfInt(word int, data []int) int {
+func indexOfString(word string, data []string) int {
 	for k, v := range data {
 		if word == v {
 			return k
@@ -0,0 +1,72 @@
+package countrycodes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name, number, country, expected string
+	}{
+		{name: "Testcase #1: Positive, local number with alpha2", number: "0812 3456 7890", country: "ID", expected: "6281234567890"},
+		{name: "Testcase #2: Positive, international number", number: "+62 812-3456-7890", country: "", expected: "6281234567890"},
+		{name: "Testcase #3: Positive, country name", number: "812 3456 7890", country: "Indonesia", expected: "6281234567890"},
+		{name: "Testcase #4: Positive, default united states", number: "(201) 555-0123", country: "", expected: "12015550123"},
+		{name: "Testcase #5: Negative, wrong length", number: "0812", country: "IDN", expected: ""},
+		{name: "Testcase #6: Negative, unknown country", number: "08123456789", country: "XX", expected: ""},
+		{name: "Testcase #7: Positive, name label", number: "0812 3456 7890 (Budi)", country: "ID", expected: "6281234567890"},
+		{name: "Testcase #8: Positive, word label", number: "0812-3456-7890 kantor", country: "ID", expected: "6281234567890"},
+		{name: "Testcase #9: Positive, label in a country with vanity numbers", number: "(201) 555-0123 home", country: "US", expected: "12015550123"},
+		{name: "Testcase #10: Positive, zero after plus sign", number: "+012139610266", country: "", expected: "12139610266"},
+		{name: "Testcase #11: Positive, international prefix after plus sign", number: "+00 62 812 3456 7890", country: "", expected: "6281234567890"},
+		{name: "Testcase #12: Negative, only zeros after plus sign", number: "+000", country: "ID", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Parse(tc.number, tc.country))
+		})
+	}
+}
+
+func TestParsePhone(t *testing.T) {
+	testCases := []struct {
+		name, number, country  string
+		alpha2, nationalNumber string
+		err                    error
+	}{
+		{name: "Testcase #1: Positive", number: "0812 3456 7890", country: "ID", alpha2: "ID", nationalNumber: "81234567890"},
+		{name: "Testcase #2: Positive, plus sign ignores country", number: "+6281234567890", country: "US", alpha2: "ID", nationalNumber: "81234567890"},
+		{name: "Testcase #3: Negative, empty number", number: " - ", country: "ID", err: ErrEmptyNumber},
+		{name: "Testcase #4: Negative, unknown country", number: "08123456789", country: "Atlantis", err: ErrUnknownCountry},
+		{name: "Testcase #5: Negative, unknown calling code", number: "+999 1234567", err: ErrUnknownCountry},
+		{name: "Testcase #6: Negative, wrong length", number: "0812", country: "ID", err: ErrInvalidLength},
+		{name: "Testcase #7: Negative, international wrong length", number: "+62 812", err: ErrInvalidLength},
+		{name: "Testcase #8: Negative, not mobile", number: "0512345678", country: "ID", err: ErrNotMobile},
+		{name: "Testcase #9: Positive, international prefix after plus sign", number: "+0062 812 3456 7890", country: "US", alpha2: "ID", nationalNumber: "81234567890"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := ParsePhone(tc.number, tc.country)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "got %v", err)
+				var parseErr *ParseError
+				assert.True(t, errors.As(err, &parseErr))
+				assert.Equal(t, tc.number, parseErr.Number)
+				assert.Equal(t, PhoneNumber{}, p)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.alpha2, p.Country.Alpha2)
+			assert.Equal(t, p.Country.CountryCode, p.CountryCode)
+			assert.Equal(t, tc.nationalNumber, p.NationalNumber)
+			assert.Equal(t, tc.number, p.RawInput)
+			assert.Equal(t, NumberTypeMobile, p.Type)
+			assert.Equal(t, "mobile", p.Type.String())
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package countrycodes
+
+import "sort"
+
+// NumberType type of phone number
+type NumberType int
+
+const (
+	// NumberTypeUnknown type for number not classified
+	NumberTypeUnknown NumberType = iota
+	// NumberTypeMobile type for mobile number
+	NumberTypeMobile
+	// NumberTypeFixedLine type for landline number
+	NumberTypeFixedLine
+	// NumberTypeTollFree type for number free of charge for the caller
+	NumberTypeTollFree
+	// NumberTypePremiumRate type for number charged above normal rate
+	NumberTypePremiumRate
+	// NumberTypeSharedCost type for number whose cost is shared between caller and recipient
+	NumberTypeSharedCost
+	// NumberTypeVoIP type for voice over IP number
+	NumberTypeVoIP
+)
+
+// numberTypes every known number type, the order breaks ties of equally long prefixes
+var numberTypes = []NumberType{
+	NumberTypeMobile, NumberTypeFixedLine, NumberTypeTollFree,
+	NumberTypePremiumRate, NumberTypeSharedCost, NumberTypeVoIP,
+}
+
+// String method for getting the name of number type
+func (t NumberType) String() string {
+	switch t {
+	case NumberTypeMobile:
+		return "mobile"
+	case NumberTypeFixedLine:
+		return "fixed_line"
+	case NumberTypeTollFree:
+		return "toll_free"
+	case NumberTypePremiumRate:
+		return "premium_rate"
+	case NumberTypeSharedCost:
+		return "shared_cost"
+	case NumberTypeVoIP:
+		return "voip"
+	}
+	return "unknown"
+}
+
+// ParseOption function for setting optional behaviour of Parse and ParsePhone
+type ParseOption func(*parseOptions)
+
+// parseOptions data structure of Parse and ParsePhone options
+type parseOptions struct {
+	types []NumberType
+}
+
+// newParseOptions function for applying opts over the default options accepting mobile numbers only
+func newParseOptions(opts []ParseOption) *parseOptions {
+	o := &parseOptions{types: []NumberType{NumberTypeMobile}}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// AllowTypes function for accepting numbers of the given types instead of mobile numbers only,
+// NumberTypeUnknown accepts a number of valid length whatever its prefix
+func AllowTypes(types ...NumberType) ParseOption {
+	return func(o *parseOptions) {
+		o.types = append([]NumberType(nil), types...)
+	}
+}
+
+// allows method for checking whether number type t is accepted
+func (o *parseOptions) allows(t NumberType) bool {
+	for _, allowed := range o.types {
+		if allowed == t {
+			return true
+		}
+	}
+	return false
+}
+
+// typeError method for getting the error of a number whose type is not accepted
+func (o *parseOptions) typeError() error {
+	if len(o.types) == 1 && o.types[0] == NumberTypeMobile {
+		return ErrNotMobile
+	}
+	return ErrNumberTypeNotAllowed
+}
+
+// resolveType method for getting the best matching accepted type of national number
+func (o *parseOptions) resolveType(nationalNumber string, iso3166 ISO3166) (NumberType, bool) {
+	for _, t := range matchTypes(nationalNumber, iso3166) {
+		if o.allows(t) {
+			return t, true
+		}
+	}
+	return NumberTypeUnknown, o.allows(NumberTypeUnknown)
+}
+
+// Classify function for getting the type of a national number of iso3166 by its longest matching prefix,
+// the number length is not checked and NumberTypeUnknown is returned when no prefix matches
+func Classify(iso3166 ISO3166, nationalNumber string) NumberType {
+	types := matchTypes(nationalNumber, iso3166)
+	if len(types) == 0 {
+		return NumberTypeUnknown
+	}
+	return types[0]
+}
+
+// BeginWith method for getting the prefixes of number type t
+func (i PhoneMetadata) BeginWith(t NumberType) []string {
+	switch t {
+	case NumberTypeMobile:
+		return i.MobileBeginWith
+	case NumberTypeFixedLine:
+		return i.FixedLineBeginWith
+	case NumberTypeTollFree:
+		return i.TollFreeBeginWith
+	case NumberTypePremiumRate:
+		return i.PremiumRateBeginWith
+	case NumberTypeSharedCost:
+		return i.SharedCostBeginWith
+	case NumberTypeVoIP:
+		return i.VoIPBeginWith
+	}
+	return nil
+}
+
+// matchTypes function for getting every type with a prefix of national number, longest prefix first
+func matchTypes(nationalNumber string, iso3166 ISO3166) []NumberType {
+	type match struct {
+		numberType NumberType
+		length     int
+	}
+
+	matches := make([]match, 0, len(numberTypes))
+	for _, t := range numberTypes {
+		length := longestPrefix(nationalNumber, iso3166.BeginWith(t))
+		if length >= 0 {
+			matches = append(matches, match{numberType: t, length: length})
+		}
+	}
+	sort.SliceStable(matches, func(a, b int) bool {
+		return matches[a].length > matches[b].length
+	})
+
+	types := make([]NumberType, len(matches))
+	for k, m := range matches {
+		types[k] = m.numberType
+	}
+	return types
+}
+
+// longestPrefix function for getting the length of the longest prefix of number, -1 when none matches
+func longestPrefix(number string, prefixes []string) int {
+	longest := -1
+	for _, w := range prefixes {
+		if len(w) > longest && len(w) <= len(number) && number[:len(w)] == w {
+			longest = len(w)
+		}
+	}
+	return longest
+}
@@ -0,0 +1,59 @@
+package countrycodes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePhoneAllowTypes(t *testing.T) {
+	all := AllowTypes(NumberTypeMobile, NumberTypeFixedLine, NumberTypeTollFree,
+		NumberTypePremiumRate, NumberTypeSharedCost, NumberTypeVoIP)
+
+	testCases := []struct {
+		name, number, country string
+		opts                  []ParseOption
+		expected              NumberType
+		err                   error
+	}{
+		{name: "Testcase #1: Positive, mobile by default", number: "0812 3456 7890", country: "ID", expected: NumberTypeMobile},
+		{name: "Testcase #2: Negative, landline by default", number: "0512345678", country: "ID", err: ErrNotMobile},
+		{name: "Testcase #3: Positive, landline allowed", number: "0512345678", country: "ID", opts: []ParseOption{all}, expected: NumberTypeFixedLine},
+		{name: "Testcase #4: Positive, longer landline prefix wins over mobile", number: "021 2345 6789", country: "ID", opts: []ParseOption{all}, expected: NumberTypeFixedLine},
+		{name: "Testcase #5: Positive, toll free", number: "0800 1234567", country: "ID", opts: []ParseOption{all}, expected: NumberTypeTollFree},
+		{name: "Testcase #6: Negative, toll free not allowed", number: "+1 800 555 0123", opts: []ParseOption{AllowTypes(NumberTypeFixedLine)}, err: ErrNumberTypeNotAllowed},
+		{name: "Testcase #7: Positive, NANP toll free", number: "+1 800 555 0123", opts: []ParseOption{all}, expected: NumberTypeTollFree},
+		{name: "Testcase #8: Positive, NANP fixed line only", number: "(201) 555-0123", opts: []ParseOption{AllowTypes(NumberTypeFixedLine)}, expected: NumberTypeFixedLine},
+		{name: "Testcase #9: Positive, international premium rate", number: "+44 909 876 5432", opts: []ParseOption{all}, expected: NumberTypePremiumRate},
+		{name: "Testcase #10: Positive, shared cost", number: "0845 123 4567", country: "GB", opts: []ParseOption{all}, expected: NumberTypeSharedCost},
+		{name: "Testcase #11: Positive, VoIP", number: "+65 3123 4567", opts: []ParseOption{all}, expected: NumberTypeVoIP},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := ParsePhone(tc.number, tc.country, tc.opts...)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, p.Type)
+		})
+	}
+}
+
+func TestParseAllowTypes(t *testing.T) {
+	assert.Equal(t, "", Parse("0512345678", "ID"))
+	assert.Equal(t, "62512345678", Parse("0512345678", "ID", AllowTypes(NumberTypeFixedLine)))
+}
+
+func TestClassify(t *testing.T) {
+	id, _ := getRegistry().byCountry("ID")
+	assert.Equal(t, NumberTypeMobile, Classify(id, "81234567890"))
+	assert.Equal(t, NumberTypeFixedLine, Classify(id, "2123456789"))
+	assert.Equal(t, NumberTypeTollFree, Classify(id, "8001234567"))
+	assert.Equal(t, NumberTypeUnknown, Classify(id, "1234"))
+	assert.Equal(t, "toll_free", NumberTypeTollFree.String())
+	assert.Equal(t, "unknown", NumberType(99).String())
+}
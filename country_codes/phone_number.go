@@ -0,0 +1,59 @@
+package countrycodes
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEmptyNumber variable for error of number without any digit
+	ErrEmptyNumber = errors.New("empty phone number")
+	// ErrUnknownCountry variable for error of unknown country or country calling code
+	ErrUnknownCountry = errors.New("unknown country")
+	// ErrInvalidLength variable for error of number length not allowed by the country
+	ErrInvalidLength = errors.New("invalid phone number length")
+	// ErrNotMobile variable for error of number not beginning with a mobile prefix of the country
+	ErrNotMobile = errors.New("phone number is not a mobile number")
+	// ErrNumberTypeNotAllowed variable for error of number type not in the types given to AllowTypes
+	ErrNumberTypeNotAllowed = errors.New("phone number type is not allowed")
+	// ErrVanityNotAllowed variable for error of vanity number of a country whose numbers are not written with letters
+	ErrVanityNotAllowed = errors.New("vanity phone number is not allowed")
+)
+
+// ParseError data structure of a phone number parsing failure, Err is one of the sentinel errors
+type ParseError struct {
+	Number  string
+	Country string
+	Err     error
+}
+
+// Error method for getting the error message
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse phone number %q: %v", e.Number, e.Err)
+}
+
+// Unwrap method for getting the sentinel error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// PhoneNumber data structure of a parsed phone number
+type PhoneNumber struct {
+	// Country ISO3166 entry the number belongs to
+	Country ISO3166
+	// CountryCode country calling code, e.g. 62
+	CountryCode string
+	// NationalNumber national significant number without trunk prefix, e.g. 81234567890
+	NationalNumber string
+	// Extension digits dialed after the number is connected, e.g. 12 of ext. 12
+	Extension string
+	// RawInput number as given to ParsePhone
+	RawInput string
+	// Type detected number type
+	Type NumberType
+}
+
+// String method for getting the country calling code followed by national number as returned by Parse
+func (p PhoneNumber) String() string {
+	return p.CountryCode + p.NationalNumber
+}
@@ -0,0 +1,311 @@
+package countrycodes
+
+import (
+	"bytes"
+	_ "embed" // embedded prefix data files
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+// embeddedCarriers default carrier table
+//
+//go:embed carriers.json
+var embeddedCarriers []byte
+
+// embeddedAreas default area table
+//
+//go:embed areas.json
+var embeddedAreas []byte
+
+// prefixDataVersion version of the prefix data file format
+const prefixDataVersion = 1
+
+// PrefixTable table of names by number prefix
+type PrefixTable int
+
+const (
+	// CarrierTable table of the mobile operator a number range was allocated to
+	CarrierTable PrefixTable = iota + 1
+	// AreaTable table of the geographic area of fixed line number ranges
+	AreaTable
+)
+
+// LookupBasis how the result of a phone number lookup was found
+type LookupBasis int
+
+const (
+	// LookupBasisPrefix result of the number range the number belongs to, a number ported
+	// to another carrier still reports the carrier its range was allocated to
+	LookupBasisPrefix LookupBasis = iota + 1
+)
+
+// String method for getting the name of the lookup basis
+func (b LookupBasis) String() string {
+	if b == LookupBasisPrefix {
+		return "prefix"
+	}
+	return "unknown"
+}
+
+var (
+	// ErrUnknownPrefixTable variable for error of prefix table other than CarrierTable or AreaTable
+	ErrUnknownPrefixTable = errors.New("unknown prefix table")
+	// ErrDuplicatePrefix variable for error of prefix used by more than one entry of a prefix table
+	ErrDuplicatePrefix = errors.New("duplicate prefix")
+	// ErrEmptyPrefixName variable for error of prefix entry without name
+	ErrEmptyPrefixName = errors.New("empty prefix name")
+	// ErrUnknownSubdivision variable for error of prefix entry subdivision missing from the ISO 3166-2 data
+	ErrUnknownSubdivision = errors.New("unknown subdivision")
+)
+
+// PrefixEntry data structure of a prefix table entry, Prefix starts with the calling code and
+// Subdivision is the optional ISO 3166-2 code of an area
+type PrefixEntry struct {
+	Prefix      string
+	Name        string
+	Subdivision string
+}
+
+// PrefixLookup data structure of the entry found for a phone number by its longest prefix
+type PrefixLookup struct {
+	Name        string
+	Subdivision string
+	Prefix      string
+	Basis       LookupBasis
+}
+
+// PrefixDataError data structure of an invalid prefix data entry, Err is one of the prefix data sentinel errors
+type PrefixDataError struct {
+	Index  int
+	Prefix string
+	Err    error
+}
+
+// Error method for getting the error message
+func (e *PrefixDataError) Error() string {
+	return fmt.Sprintf("prefix data entry %d (%s): %v", e.Index, e.Prefix, e.Err)
+}
+
+// Unwrap method for getting the sentinel error
+func (e *PrefixDataError) Unwrap() error {
+	return e.Err
+}
+
+// Carrier method for getting the mobile operator the number range of the phone number was allocated to
+func (p PhoneNumber) Carrier() (PrefixLookup, bool) {
+	return getPrefixTables().carriers.lookup(p.String())
+}
+
+// Area method for getting the geographic area of the number range of the phone number,
+// only fixed line numbers and numbers of unknown type have an area
+func (p PhoneNumber) Area() (PrefixLookup, bool) {
+	if p.Type != NumberTypeFixedLine && p.Type != NumberTypeUnknown {
+		return PrefixLookup{}, false
+	}
+	return getPrefixTables().areas.lookup(p.String())
+}
+
+// prefixDataFile data structure of a prefix data file
+type prefixDataFile struct {
+	Version int               `json:"version"`
+	Entries []prefixDataEntry `json:"entries"`
+}
+
+// prefixDataEntry data structure of an entry in a prefix data file
+type prefixDataEntry struct {
+	Prefix      string `json:"prefix"`
+	Name        string `json:"name"`
+	Subdivision string `json:"subdivision,omitempty"`
+}
+
+// ReadPrefixData function for decoding and validating the entries of a prefix data file,
+// a validation failure is returned as *PrefixDataError
+func ReadPrefixData(r io.Reader) ([]PrefixEntry, error) {
+	var file prefixDataFile
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		return nil, err
+	}
+	if file.Version != prefixDataVersion {
+		return nil, ErrMetadataVersion
+	}
+
+	entries := make([]PrefixEntry, len(file.Entries))
+	prefixes := make(map[string]bool, len(file.Entries))
+	for k, e := range file.Entries {
+		entries[k] = PrefixEntry(e)
+
+		var err error
+		switch {
+		case e.Prefix == "" || digitsOnly(e.Prefix) != e.Prefix:
+			err = ErrInvalidPrefix
+		case prefixes[e.Prefix]:
+			err = ErrDuplicatePrefix
+		case e.Name == "":
+			err = ErrEmptyPrefixName
+		case e.Subdivision != "" && !knownSubdivision(e.Subdivision):
+			err = ErrUnknownSubdivision
+		}
+		if err != nil {
+			return nil, &PrefixDataError{Index: k, Prefix: e.Prefix, Err: err}
+		}
+		prefixes[e.Prefix] = true
+	}
+	return entries, nil
+}
+
+// knownSubdivision function for checking that code is an ISO 3166-2 code of the loaded country data
+func knownSubdivision(code string) bool {
+	_, ok := LookupSubdivision(code)
+	return ok
+}
+
+// WritePrefixData function for encoding entries into a prefix data file, one entry per line
+func WritePrefixData(w io.Writer, entries []PrefixEntry) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "{\"version\":%d,\"entries\":[\n", prefixDataVersion)
+	for k, e := range entries {
+		line, err := json.Marshal(prefixDataEntry(e))
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		if k < len(entries)-1 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("]}\n")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// LoadPrefixData function for replacing table with a prefix data file, the current table is kept when r is invalid
+func LoadPrefixData(table PrefixTable, r io.Reader) error {
+	if table != CarrierTable && table != AreaTable {
+		return ErrUnknownPrefixTable
+	}
+	entries, err := ReadPrefixData(r)
+	if err != nil {
+		return err
+	}
+
+	prefixTablesMu.Lock()
+	defer prefixTablesMu.Unlock()
+	tables := *getPrefixTables()
+	if table == CarrierTable {
+		tables.carriers = newPrefixIndex(entries)
+	} else {
+		tables.areas = newPrefixIndex(entries)
+	}
+	setPrefixTables(&tables)
+	return nil
+}
+
+// LoadPrefixDataFile function for replacing table with the prefix data file at path
+func LoadPrefixDataFile(table PrefixTable, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return LoadPrefixData(table, f)
+}
+
+// ResetPrefixData function for restoring the embedded carrier and area tables
+func ResetPrefixData() error {
+	tables, err := embeddedPrefixTables()
+	if err != nil {
+		return err
+	}
+	prefixTablesMu.Lock()
+	defer prefixTablesMu.Unlock()
+	setPrefixTables(tables)
+	return nil
+}
+
+// prefixTables data structure of the immutable carrier and area tables
+type prefixTables struct {
+	carriers *prefixIndex
+	areas    *prefixIndex
+}
+
+// prefixIndex data structure of prefix table entries by prefix
+type prefixIndex struct {
+	entries   map[string]PrefixEntry
+	maxLength int
+}
+
+var (
+	currentPrefixTables atomic.Value
+	prefixTablesOnce    sync.Once
+	// prefixTablesMu serializes table replacements, lookups only load currentPrefixTables
+	prefixTablesMu sync.Mutex
+)
+
+// getPrefixTables function for getting the prefix tables, built from the embedded files on first call
+func getPrefixTables() *prefixTables {
+	prefixTablesOnce.Do(func() {
+		if currentPrefixTables.Load() != nil {
+			return
+		}
+		tables, err := embeddedPrefixTables()
+		if err != nil {
+			panic("countrycodes: invalid embedded prefix data: " + err.Error())
+		}
+		currentPrefixTables.Store(tables)
+	})
+	return currentPrefixTables.Load().(*prefixTables)
+}
+
+// setPrefixTables function for replacing the prefix tables used by every lookup
+func setPrefixTables(tables *prefixTables) {
+	prefixTablesOnce.Do(func() {})
+	currentPrefixTables.Store(tables)
+}
+
+// embeddedPrefixTables function for building the prefix tables of the embedded files
+func embeddedPrefixTables() (*prefixTables, error) {
+	carriers, err := ReadPrefixData(bytes.NewReader(embeddedCarriers))
+	if err != nil {
+		return nil, err
+	}
+	areas, err := ReadPrefixData(bytes.NewReader(embeddedAreas))
+	if err != nil {
+		return nil, err
+	}
+	return &prefixTables{carriers: newPrefixIndex(carriers), areas: newPrefixIndex(areas)}, nil
+}
+
+// newPrefixIndex function for indexing entries by prefix
+func newPrefixIndex(entries []PrefixEntry) *prefixIndex {
+	index := &prefixIndex{entries: make(map[string]PrefixEntry, len(entries))}
+	for _, e := range entries {
+		index.entries[e.Prefix] = e
+		if len(e.Prefix) > index.maxLength {
+			index.maxLength = len(e.Prefix)
+		}
+	}
+	return index
+}
+
+// lookup method for getting the entry of the longest prefix of number
+func (i *prefixIndex) lookup(number string) (PrefixLookup, bool) {
+	length := i.maxLength
+	if len(number) < length {
+		length = len(number)
+	}
+	for ; length > 0; length-- {
+		if e, ok := i.entries[number[:length]]; ok {
+			return PrefixLookup{Name: e.Name, Subdivision: e.Subdivision, Prefix: e.Prefix, Basis: LookupBasisPrefix}, true
+		}
+	}
+	return PrefixLookup{}, false
+}
@@ -0,0 +1,143 @@
+package countrycodes
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadWritePrefixData(t *testing.T) {
+	for _, embedded := range [][]byte{embeddedCarriers, embeddedAreas} {
+		entries, err := ReadPrefixData(bytes.NewReader(embedded))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, entries)
+
+		var buf bytes.Buffer
+		assert.NoError(t, WritePrefixData(&buf, entries))
+		assert.Equal(t, string(embedded), buf.String())
+	}
+}
+
+func TestReadPrefixDataValidation(t *testing.T) {
+	testCases := []struct {
+		name, data string
+		err        error
+	}{
+		{name: "Testcase #1: Positive", data: `{"version":1,"entries":[{"prefix":"62811","name":"Telkomsel"}]}`},
+		{name: "Testcase #2: Negative, version", data: `{"version":2,"entries":[]}`, err: ErrMetadataVersion},
+		{name: "Testcase #3: Negative, invalid prefix", data: `{"version":1,"entries":[{"prefix":"+62811","name":"Telkomsel"}]}`,
+			err: ErrInvalidPrefix},
+		{name: "Testcase #4: Negative, empty prefix", data: `{"version":1,"entries":[{"prefix":"","name":"Telkomsel"}]}`,
+			err: ErrInvalidPrefix},
+		{name: "Testcase #5: Negative, duplicate prefix", data: `{"version":1,"entries":[{"prefix":"62811","name":"Telkomsel"},` +
+			`{"prefix":"62811","name":"Indosat"}]}`, err: ErrDuplicatePrefix},
+		{name: "Testcase #6: Negative, empty name", data: `{"version":1,"entries":[{"prefix":"62811","name":""}]}`,
+			err: ErrEmptyPrefixName},
+		{name: "Testcase #7: Positive, subdivision", data: `{"version":1,"entries":[{"prefix":"6221","name":"Jakarta","subdivision":"ID-JK"}]}`},
+		{name: "Testcase #8: Negative, unknown subdivision", data: `{"version":1,"entries":[{"prefix":"6221","name":"Jakarta","subdivision":"ID-XX"}]}`,
+			err: ErrUnknownSubdivision},
+		{name: "Testcase #9: Negative, subdivision code", data: `{"version":1,"entries":[{"prefix":"6221","name":"Jakarta","subdivision":"Jakarta"}]}`,
+			err: ErrUnknownSubdivision},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := ReadPrefixData(strings.NewReader(tc.data))
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "got %v", err)
+				assert.Nil(t, entries)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, entries, 1)
+		})
+	}
+
+	var prefixErr *PrefixDataError
+	_, err := ReadPrefixData(strings.NewReader(`{"version":1,"entries":[{"prefix":"62811","name":"Telkomsel"},` +
+		`{"prefix":"62811","name":"Indosat"}]}`))
+	assert.True(t, errors.As(err, &prefixErr))
+	assert.Equal(t, "prefix data entry 1 (62811): duplicate prefix", prefixErr.Error())
+}
+
+func TestPhoneNumberCarrierAndArea(t *testing.T) {
+	all := AllowTypes(NumberTypeMobile, NumberTypeFixedLine)
+	testCases := []struct {
+		name, number, country, carrier, area, subdivision string
+	}{
+		{name: "Testcase #1: Telkomsel", number: "0812 3456 7890", country: "ID", carrier: "Telkomsel"},
+		{name: "Testcase #2: Indosat", number: "+62 857 1234 5678", carrier: "Indosat Ooredoo Hutchison"},
+		{name: "Testcase #3: XL", number: "0877 1234 5678", country: "ID", carrier: "XL Axiata"},
+		{name: "Testcase #4: Jakarta landline", number: "021 8350 1234", country: "ID", area: "Jakarta", subdivision: "ID-JK"},
+		{name: "Testcase #5: longest prefix wins", number: "0274 512 345", country: "ID", area: "Yogyakarta", subdivision: "ID-YO"},
+		{name: "Testcase #6: Malaysian mobile", number: "012-345 6789", country: "MY", carrier: "Maxis"},
+		{name: "Testcase #7: number without data", number: "+44 7911 123456"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := ParsePhone(tc.number, tc.country, all)
+			assert.NoError(t, err)
+
+			carrier, ok := p.Carrier()
+			assert.Equal(t, tc.carrier != "", ok)
+			assert.Equal(t, tc.carrier, carrier.Name)
+			if ok {
+				assert.Equal(t, LookupBasisPrefix, carrier.Basis)
+				assert.True(t, strings.HasPrefix(p.String(), carrier.Prefix))
+			}
+
+			area, ok := p.Area()
+			assert.Equal(t, tc.area != "", ok)
+			assert.Equal(t, tc.area, area.Name)
+			assert.Equal(t, tc.subdivision, area.Subdivision)
+		})
+	}
+
+	assert.Equal(t, "prefix", LookupBasisPrefix.String())
+}
+
+func TestLoadPrefixDataFile(t *testing.T) {
+	defer ResetPrefixData()
+
+	p, err := ParsePhone("0812 3456 7890", "ID")
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "carriers.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"version":1,"entries":[{"prefix":"628123","name":"Telkomsel Halo"}]}`), 0644))
+	assert.NoError(t, LoadPrefixDataFile(CarrierTable, path))
+
+	carrier, ok := p.Carrier()
+	assert.True(t, ok)
+	assert.Equal(t, "Telkomsel Halo", carrier.Name)
+	// the area table is untouched
+	_, ok = p.Area()
+	assert.False(t, ok)
+
+	// a mobile number has no area even when an area prefix matches
+	assert.NoError(t, LoadPrefixData(AreaTable, strings.NewReader(`{"version":1,"entries":[{"prefix":"62812","name":"Jakarta"}]}`)))
+	_, ok = p.Area()
+	assert.False(t, ok)
+	// a number of unknown type may have an area
+	unknown, err := ParsePhone("0812 3456 7890", "ID", AllowTypes(NumberTypeUnknown))
+	assert.NoError(t, err)
+	area, ok := unknown.Area()
+	assert.True(t, ok)
+	assert.Equal(t, "Jakarta", area.Name)
+
+	// invalid file keeps the loaded table
+	assert.Error(t, LoadPrefixData(CarrierTable, strings.NewReader(`{"version":1,"entries":[{"prefix":"x","name":"x"}]}`)))
+	assert.Equal(t, ErrUnknownPrefixTable, LoadPrefixData(PrefixTable(0), strings.NewReader(`{"version":1,"entries":[]}`)))
+	assert.Error(t, LoadPrefixDataFile(CarrierTable, filepath.Join(t.TempDir(), "missing.json")))
+	carrier, _ = p.Carrier()
+	assert.Equal(t, "Telkomsel Halo", carrier.Name)
+
+	assert.NoError(t, ResetPrefixData())
+	carrier, _ = p.Carrier()
+	assert.Equal(t, "Telkomsel", carrier.Name)
+}
@@ -0,0 +1,74 @@
+package countrycodes
+
+import "sort"
+
+// MainCountry function for getting the main country of calling code, false when the code is unknown
+func MainCountry(callingCode string) (ISO3166, bool) {
+	reg := getRegistry()
+	if k, ok := reg.mainCountries[callingCode]; ok {
+		return reg.countries[k].clone(), true
+	}
+	for _, k := range reg.candidates(callingCode) {
+		if reg.countries[k].CountryCode == callingCode {
+			return reg.countries[k].clone(), true
+		}
+	}
+	return ISO3166{}, false
+}
+
+// CandidateRegions function for getting every country an international number may belong to,
+// best match first. More than one country with the same calling code means the number is ambiguous
+// and the first one is the country ParsePhone resolves to
+func CandidateRegions(number string, opts ...ParseOption) []ISO3166 {
+	number = digitsOnly(number)
+	if number == "" {
+		return nil
+	}
+	regions := resolveRegions(number, newParseOptions(opts))
+	for k, i := range regions {
+		regions[k] = i.clone()
+	}
+	return regions
+}
+
+// resolveRegions function for getting countries whose calling code, length and accepted type prefixes
+// match number, ordered by the longest matching leading digits, then the main country of the calling code,
+// then data order
+func resolveRegions(number string, options *parseOptions) []ISO3166 {
+	type region struct {
+		index, leading int
+		main           bool
+	}
+
+	reg := getRegistry()
+	regions := make([]region, 0, 1)
+	for _, k := range reg.candidates(number) {
+		i := reg.countries[k]
+		nationalNumber := number[len(i.CountryCode):]
+		if indexOfInt(len(nationalNumber), i.PhoneNumberLengths) == -1 {
+			continue
+		}
+		numberType, ok := options.resolveType(nationalNumber, i)
+		if !ok {
+			continue
+		}
+		regions = append(regions, region{
+			index:   k,
+			leading: longestPrefix(nationalNumber, i.BeginWith(numberType)),
+			main:    i.MainCountryForCode,
+		})
+	}
+
+	sort.SliceStable(regions, func(a, b int) bool {
+		if regions[a].leading != regions[b].leading {
+			return regions[a].leading > regions[b].leading
+		}
+		return regions[a].main && !regions[b].main
+	})
+
+	iso3166s := make([]ISO3166, len(regions))
+	for k, r := range regions {
+		iso3166s[k] = reg.countries[r.index]
+	}
+	return iso3166s
+}
@@ -0,0 +1,82 @@
+package countrycodes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePhoneNANPTerritories(t *testing.T) {
+	for _, i := range GetISO3166() {
+		if i.CountryCode != "1" {
+			continue
+		}
+		for _, prefix := range i.MobileBeginWith {
+			number := "+1" + prefix + strings.Repeat("5", 10-len(prefix))
+			t.Run(i.Alpha2+" "+number, func(t *testing.T) {
+				p, err := ParsePhone(number, "")
+				assert.NoError(t, err)
+				assert.Equal(t, i.Alpha2, p.Country.Alpha2)
+				assert.Equal(t, []ISO3166{p.Country}, CandidateRegions(number))
+			})
+		}
+	}
+}
+
+func TestParsePhoneSharedCallingCode(t *testing.T) {
+	testCases := []struct {
+		name, number, expected string
+	}{
+		{name: "Testcase #1: Kazakhstan on 7", number: "+7 701 234 5678", expected: "KZ"},
+		{name: "Testcase #2: Russia on 7", number: "+7 912 345 6789", expected: "RU"},
+		{name: "Testcase #3: Åland Islands on 358", number: "+358 18 123456", expected: "AX"},
+		{name: "Testcase #4: Finland on 358", number: "+358 40 1234567", expected: "FI"},
+		{name: "Testcase #5: Comoros on 269", number: "+269 321 2345", expected: "KM"},
+		{name: "Testcase #6: Mayotte on 269", number: "+269 639 123 456", expected: "YT"},
+		{name: "Testcase #7: Jamaica local number", number: "876 555 1234", expected: "JM"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			country := ""
+			if !strings.HasPrefix(tc.number, "+") {
+				country = tc.expected
+			}
+			p, err := ParsePhone(tc.number, country)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, p.Country.Alpha2)
+		})
+	}
+}
+
+func TestCandidateRegionsAmbiguous(t *testing.T) {
+	regions := CandidateRegions("+1 800 555 0123", AllowTypes(NumberTypeTollFree))
+	assert.Len(t, regions, 25)
+	assert.Equal(t, "US", regions[0].Alpha2)
+
+	p, err := ParsePhone("+1 800 555 0123", "", AllowTypes(NumberTypeTollFree))
+	assert.NoError(t, err)
+	assert.Equal(t, "US", p.Country.Alpha2)
+
+	assert.Empty(t, CandidateRegions("+999 1234567"))
+	assert.Empty(t, CandidateRegions(""))
+}
+
+func TestMainCountry(t *testing.T) {
+	testCases := []struct {
+		callingCode, expected string
+	}{
+		{callingCode: "1", expected: "US"},
+		{callingCode: "7", expected: "RU"},
+		{callingCode: "358", expected: "FI"},
+		{callingCode: "62", expected: "ID"},
+		{callingCode: "999", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		i, ok := MainCountry(tc.callingCode)
+		assert.Equal(t, tc.expected != "", ok)
+		assert.Equal(t, tc.expected, i.Alpha2)
+	}
+}
@@ -0,0 +1,226 @@
+package countrycodes
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+)
+
+// registry data structure of immutable lookup tables of countries, countries holds the
+// ISO3166 datas of countries with phone metadata in data order
+type registry struct {
+	all            []Country
+	countryByCode  map[string]int
+	countryByName  map[string]int
+	countries      []ISO3166
+	byAlpha2       map[string]int
+	byAlpha3       map[string]int
+	byName         map[string]int
+	mainCountries  map[string]int
+	callingCodes   *callingCodeNode
+	formatPatterns map[string]*regexp.Regexp
+}
+
+// callingCodeNode data structure of a calling code trie node, countries holds the
+// indexes of countries whose calling code ends at this node in data order
+type callingCodeNode struct {
+	children  [10]*callingCodeNode
+	countries []int
+}
+
+var (
+	currentRegistry atomic.Value
+	registryOnce    sync.Once
+)
+
+// getRegistry function for getting the registry, built from the embedded metadata on first call
+// unless LoadMetadata replaced it before
+func getRegistry() *registry {
+	registryOnce.Do(func() {
+		if currentRegistry.Load() != nil {
+			return
+		}
+		reg, err := embeddedRegistry()
+		if err != nil {
+			panic("countrycodes: invalid embedded metadata: " + err.Error())
+		}
+		currentRegistry.Store(reg)
+	})
+	return currentRegistry.Load().(*registry)
+}
+
+// setRegistry function for replacing the registry used by every lookup
+func setRegistry(reg *registry) {
+	registryOnce.Do(func() {})
+	currentRegistry.Store(reg)
+}
+
+// newRegistry function for validating and indexing countries
+func newRegistry(all []Country) (*registry, error) {
+	if err := validateMetadata(all); err != nil {
+		return nil, err
+	}
+
+	countries := make([]ISO3166, 0, len(all))
+	for _, c := range all {
+		if i, ok := c.ISO3166(); ok {
+			countries = append(countries, i)
+		}
+	}
+
+	r := &registry{
+		all:            all,
+		countryByCode:  make(map[string]int, 3*len(all)),
+		countryByName:  make(map[string]int, len(all)),
+		countries:      countries,
+		byAlpha2:       make(map[string]int, len(countries)),
+		byAlpha3:       make(map[string]int, len(countries)),
+		byName:         make(map[string]int, len(countries)),
+		mainCountries:  make(map[string]int),
+		callingCodes:   &callingCodeNode{},
+		formatPatterns: make(map[string]*regexp.Regexp),
+	}
+
+	for k, c := range all {
+		r.countryByCode[c.Alpha2] = k
+		r.countryByCode[c.Alpha3] = k
+		r.countryByCode[c.Numeric] = k
+		addCountryNames(r.countryByName, countryNames(c), k)
+	}
+	// localized names come after every english name so they never shadow one
+	for k, c := range all {
+		addCountryNames(r.countryByName, localizedNames(c), k)
+	}
+
+	for k, i := range countries {
+		r.byAlpha2[i.Alpha2] = k
+		r.byAlpha3[i.Alpha3] = k
+		if i.MainCountryForCode {
+			r.mainCountries[i.CountryCode] = k
+		}
+		addCountryNames(r.byName, countryNames(all[r.countryByCode[i.Alpha2]]), k)
+
+		node := r.callingCodes
+		for _, c := range i.CountryCode {
+			d := c - '0'
+			if node.children[d] == nil {
+				node.children[d] = &callingCodeNode{}
+			}
+			node = node.children[d]
+		}
+		node.countries = append(node.countries, k)
+
+		for _, f := range i.NumberFormats {
+			for _, expr := range formatExprs(f) {
+				if _, ok := r.formatPatterns[expr]; !ok {
+					// patterns are compiled by validateMetadata already
+					r.formatPatterns[expr] = regexp.MustCompile(expr)
+				}
+			}
+		}
+	}
+
+	for k, i := range countries {
+		addCountryNames(r.byName, localizedNames(all[r.countryByCode[i.Alpha2]]), k)
+	}
+
+	return r, nil
+}
+
+// addCountryNames function for indexing names to k, the first country wins
+func addCountryNames(byName map[string]int, names []string, k int) {
+	for _, name := range names {
+		if name = normalizeCountryName(name); name == "" {
+			continue
+		}
+		if _, ok := byName[name]; !ok {
+			byName[name] = k
+		}
+	}
+}
+
+// countryIndex method for getting the index in all of the country with alpha2, alpha3, numeric code or name key
+func (r *registry) countryIndex(key string) (int, bool) {
+	key = strings.TrimSpace(key)
+	if key != "" && digitsOnly(key) == key && len(key) <= 3 {
+		k, ok := r.countryByCode[strings.Repeat("0", 3-len(key))+key]
+		return k, ok
+	}
+	if len(key) == 2 || len(key) == 3 {
+		if k, ok := r.countryByCode[strings.ToUpper(key)]; ok {
+			return k, true
+		}
+	}
+	k, ok := r.countryByName[normalizeCountryName(key)]
+	return k, ok
+}
+
+// byCountry method for getting country by alpha2, alpha3 or country name
+func (r *registry) byCountry(country string) (ISO3166, bool) {
+	var (
+		k  int
+		ok bool
+	)
+	switch len(country) {
+	case 0:
+		return r.countries[0], true
+	case 2:
+		k, ok = r.byAlpha2[strings.ToUpper(country)]
+	case 3:
+		k, ok = r.byAlpha3[strings.ToUpper(country)]
+	default:
+		k, ok = r.byName[normalizeCountryName(country)]
+	}
+	if !ok {
+		return ISO3166{}, false
+	}
+	return r.countries[k], true
+}
+
+// candidates method for getting indexes of countries whose calling code is a prefix of number
+func (r *registry) candidates(number string) []int {
+	var indexes []int
+	node := r.callingCodes
+	for i := 0; i < len(number); i++ {
+		c := number[i]
+		if c < '0' || c > '9' {
+			break
+		}
+		node = node.children[c-'0']
+		if node == nil {
+			break
+		}
+		indexes = append(indexes, node.countries...)
+	}
+	return indexes
+}
+
+// pattern method for getting the compiled number format pattern, nil when expr is invalid
+func (r *registry) pattern(expr string) *regexp.Regexp {
+	if p, ok := r.formatPatterns[expr]; ok {
+		return p
+	}
+	p, _ := regexp.Compile(expr)
+	return p
+}
+
+// normalizeCountryName function for upper casing name and dropping anything but letters,
+// combining marks such as Thai vowels are kept
+func normalizeCountryName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if !unicode.IsLetter(r) && !unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, name)
+}
+
+// formatExprs function for getting the regular expressions of number format f
+func formatExprs(f NumberFormat) []string {
+	if f.LeadingDigits == "" {
+		return []string{f.Pattern}
+	}
+	return []string{f.Pattern, leadingDigitsPattern(f.LeadingDigits)}
+}
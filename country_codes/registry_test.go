@@ -0,0 +1,88 @@
+package countrycodes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistryByCountry(t *testing.T) {
+	testCases := []struct {
+		name, country, expected string
+	}{
+		{name: "Testcase #1: empty country", country: "", expected: "US"},
+		{name: "Testcase #2: alpha2", country: "id", expected: "ID"},
+		{name: "Testcase #3: alpha3", country: "MYS", expected: "MY"},
+		{name: "Testcase #4: country name with space", country: "united kingdom", expected: "GB"},
+		{name: "Testcase #5: country name without space", country: "UnitedKingdom", expected: "GB"},
+		{name: "Testcase #6: unknown", country: "Atlantis", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			i, _ := getRegistry().byCountry(tc.country)
+			assert.Equal(t, tc.expected, i.Alpha2)
+		})
+	}
+}
+
+func TestGetISO3166(t *testing.T) {
+	datas := GetISO3166()
+	assert.Len(t, datas, 227)
+
+	// callers must not be able to change the registry
+	datas[0].Alpha2 = "XX"
+	for k := range datas[0].MobileBeginWith {
+		datas[0].MobileBeginWith[k] = "0"
+	}
+	datas[0].PhoneNumberLengths[0] = 0
+	assert.Equal(t, "US", GetISO3166()[0].Alpha2)
+	assert.Equal(t, "12015550123", Parse("+12015550123", ""))
+
+	us, ok := MainCountry("1")
+	assert.True(t, ok)
+	us.MobileBeginWith[0] = "0"
+	assert.Equal(t, "12015550123", Parse("+12015550123", ""))
+}
+
+func TestRegistryCandidates(t *testing.T) {
+	reg := getRegistry()
+
+	var alpha2 []string
+	for _, k := range reg.candidates("6281234567890") {
+		alpha2 = append(alpha2, reg.countries[k].Alpha2)
+	}
+	assert.Equal(t, []string{"ID"}, alpha2)
+	assert.Empty(t, reg.candidates("999"))
+	assert.Len(t, reg.candidates("12015550123"), 25)
+}
+
+// contactList sample of contact numbers as stored by users
+var contactList = []struct{ number, country string }{
+	{"0812 3456 7890", "ID"},
+	{"+62 812-3456-7890", ""},
+	{"(201) 555-0123", ""},
+	{"+44 7911 123456", ""},
+	{"012-345 6789", "Malaysia"},
+	{"+65 9123 4567", ""},
+	{"8 912 345 67 89", "RUS"},
+	{"0812", "ID"},
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for _, c := range contactList {
+			Parse(c.number, c.country)
+		}
+	}
+}
+
+func BenchmarkParsePhone(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for _, c := range contactList {
+			ParsePhone(c.number, c.country)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/willy182/goshare/shared"
+)
+
+// Rule identifier of a password policy rule, stable for localizing violation messages
+type Rule string
+
+const (
+	// RuleMinLength rule for password shorter than Policy.MinLength
+	RuleMinLength Rule = "min_length"
+	// RuleMaxLength rule for password longer than Policy.MaxLength
+	RuleMaxLength Rule = "max_length"
+	// RuleUppercase rule for password without uppercase character
+	RuleUppercase Rule = "uppercase"
+	// RuleLowercase rule for password without lowercase character
+	RuleLowercase Rule = "lowercase"
+	// RuleNumeric rule for password without numeric character
+	RuleNumeric Rule = "numeric"
+	// RuleSymbol rule for password without symbol character
+	RuleSymbol Rule = "symbol"
+	// RuleMaxRepeated rule for password repeating a character more than Policy.MaxRepeated times in a row
+	RuleMaxRepeated Rule = "max_repeated"
+	// RuleForbiddenSubstring rule for password containing a forbidden substring or user input
+	RuleForbiddenSubstring Rule = "forbidden_substring"
+	// RuleCommonPassword rule for password found in the common password dictionary
+	RuleCommonPassword Rule = "common_password"
+	// RuleMinScore rule for password with strength score below Policy.MinScore
+	RuleMinScore Rule = "min_score"
+)
+
+// Violation data structure of a broken policy rule,
+// Limit holds the configured number and Value the offending text when relevant to the rule
+type Violation struct {
+	Rule  Rule
+	Limit int
+	Value string
+}
+
+// Error method for getting the english message of the violation
+func (v Violation) Error() string {
+	switch v.Rule {
+	case RuleMinLength:
+		return fmt.Sprintf("password must be at least %d characters", v.Limit)
+	case RuleMaxLength:
+		return fmt.Sprintf("password must be at most %d characters", v.Limit)
+	case RuleUppercase:
+		return "password must contain an uppercase letter"
+	case RuleLowercase:
+		return "password must contain a lowercase letter"
+	case RuleNumeric:
+		return "password must contain a number"
+	case RuleSymbol:
+		return "password must contain a symbol"
+	case RuleMaxRepeated:
+		return fmt.Sprintf("password must not repeat a character more than %d times in a row", v.Limit)
+	case RuleForbiddenSubstring:
+		return fmt.Sprintf("password must not contain %q", v.Value)
+	case RuleCommonPassword:
+		return "password is too common"
+	case RuleMinScore:
+		return fmt.Sprintf("password strength must be at least %d", v.Limit)
+	}
+	return string(v.Rule)
+}
+
+// Policy data structure of password rules, a zero value field disables its rule
+type Policy struct {
+	MinLength           int
+	MaxLength           int
+	RequireUppercase    bool
+	RequireLowercase    bool
+	RequireNumeric      bool
+	RequireSymbol       bool
+	MaxRepeated         int
+	ForbiddenSubstrings []string
+	RejectCommon        bool
+	MinScore            int
+}
+
+// DefaultPolicy function for getting the recommended password policy
+func DefaultPolicy() Policy {
+	return Policy{
+		MinLength:        8,
+		MaxLength:        64,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireNumeric:   true,
+		MaxRepeated:      3,
+		RejectCommon:     true,
+		MinScore:         2,
+	}
+}
+
+// Validate method for checking password against the policy,
+// userInputs such as username or email are forbidden as substrings and
+// lower the strength estimate, an email also forbids its local part
+func (p Policy) Validate(password string, userInputs ...string) []Violation {
+	violations := make([]Violation, 0)
+
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		violations = append(violations, Violation{Rule: RuleMinLength, Limit: p.MinLength})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, Violation{Rule: RuleMaxLength, Limit: p.MaxLength})
+	}
+
+	var uppercase, lowercase, num, symbol int
+	for _, r := range password {
+		if shared.IsUppercase(r) {
+			uppercase++
+		} else if shared.IsLowercase(r) {
+			lowercase++
+		} else if shared.IsNumeric(r) {
+			num++
+		} else { // allowed symbols and any non latin character
+			symbol++
+		}
+	}
+
+	if p.RequireUppercase && uppercase == 0 {
+		violations = append(violations, Violation{Rule: RuleUppercase})
+	}
+	if p.RequireLowercase && lowercase == 0 {
+		violations = append(violations, Violation{Rule: RuleLowercase})
+	}
+	if p.RequireNumeric && num == 0 {
+		violations = append(violations, Violation{Rule: RuleNumeric})
+	}
+	if p.RequireSymbol && symbol == 0 {
+		violations = append(violations, Violation{Rule: RuleSymbol})
+	}
+
+	if p.MaxRepeated > 0 && longestRepeat(password) > p.MaxRepeated {
+		violations = append(violations, Violation{Rule: RuleMaxRepeated, Limit: p.MaxRepeated})
+	}
+
+	// copy ForbiddenSubstrings so that appending the user inputs never writes into its backing array
+	userForbidden := expandUserInputs(userInputs)
+	forbiddenSubstrings := make([]string, len(p.ForbiddenSubstrings), len(p.ForbiddenSubstrings)+len(userForbidden))
+	copy(forbiddenSubstrings, p.ForbiddenSubstrings)
+	forbiddenSubstrings = append(forbiddenSubstrings, userForbidden...)
+
+	lower := strings.ToLower(password)
+	for _, forbidden := range forbiddenSubstrings {
+		if forbidden != "" && strings.Contains(lower, strings.ToLower(forbidden)) {
+			violations = append(violations, Violation{Rule: RuleForbiddenSubstring, Value: forbidden})
+		}
+	}
+
+	if p.RejectCommon && isCommonPassword(password) {
+		violations = append(violations, Violation{Rule: RuleCommonPassword})
+	}
+
+	if p.MinScore > 0 && Estimate(password, userInputs...).Score < p.MinScore {
+		violations = append(violations, Violation{Rule: RuleMinScore, Limit: p.MinScore})
+	}
+
+	return violations
+}
+
+// longestRepeat function for getting the longest run of the same character
+func longestRepeat(str string) int {
+	var longest, current int
+	var last rune = -1
+	for _, r := range str {
+		if r == last {
+			current++
+		} else {
+			current = 1
+			last = r
+		}
+		if current > longest {
+			longest = current
+		}
+	}
+	return longest
+}
+
+// expandUserInputs function for adding the local part of every email and
+// dropping inputs too short to be meaningful
+func expandUserInputs(userInputs []string) []string {
+	expanded := make([]string, 0, len(userInputs))
+	for _, input := range userInputs {
+		input = strings.TrimSpace(input)
+		if i := strings.LastIndex(input, "@"); i > 0 {
+			if local := input[:i]; utf8.RuneCountInString(local) >= 3 {
+				expanded = append(expanded, local)
+			}
+		}
+		if utf8.RuneCountInString(input) >= 3 {
+			expanded = append(expanded, input)
+		}
+	}
+	return expanded
+}
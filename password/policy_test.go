@@ -0,0 +1,91 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rules(violations []Violation) []Rule {
+	result := make([]Rule, 0, len(violations))
+	for _, v := range violations {
+		result = append(result, v.Rule)
+	}
+	return result
+}
+
+func TestPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     Policy
+		password   string
+		userInputs []string
+		want       []Rule
+	}{
+		{
+			name:     "Testcase #1: Positive",
+			policy:   DefaultPolicy(),
+			password: "Kj3h4vzqWp",
+			want:     []Rule{},
+		},
+		{
+			name:     "Testcase #2: Negative, too short without classes",
+			policy:   DefaultPolicy(),
+			password: "abc",
+			want:     []Rule{RuleMinLength, RuleUppercase, RuleNumeric, RuleMinScore},
+		},
+		{
+			name:     "Testcase #3: Negative, common password",
+			policy:   DefaultPolicy(),
+			password: "Password1",
+			want:     []Rule{RuleCommonPassword, RuleMinScore},
+		},
+		{
+			name:     "Testcase #4: Negative, repeated characters",
+			policy:   Policy{MaxRepeated: 2},
+			password: "Kj3h4vzqqq",
+			want:     []Rule{RuleMaxRepeated},
+		},
+		{
+			name:       "Testcase #5: Negative, email local part",
+			policy:     Policy{ForbiddenSubstrings: []string{"goshare"}},
+			password:   "Johnny-Goshare-7",
+			userInputs: []string{"johnny@example.com"},
+			want:       []Rule{RuleForbiddenSubstring, RuleForbiddenSubstring},
+		},
+		{
+			name:     "Testcase #6: Negative, symbol and max length",
+			policy:   Policy{MaxLength: 8, RequireSymbol: true},
+			password: "Kj3h4vzqWp",
+			want:     []Rule{RuleMaxLength, RuleSymbol},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.Validate(tt.password, tt.userInputs...)
+			assert.Equal(t, tt.want, rules(got))
+		})
+	}
+}
+
+func TestPolicyValidateKeepsForbiddenSubstrings(t *testing.T) {
+	forbidden := make([]string, 1, 4)
+	forbidden[0] = "goshare"
+	policy := Policy{ForbiddenSubstrings: forbidden}
+
+	policy.Validate("Kj3h4vzqWp", "johnny@example.com")
+	assert.Equal(t, []string{"goshare", "", "", ""}, forbidden[:4])
+
+	// a later call with other user inputs does not see the earlier ones
+	got := policy.Validate("Kj3h4vzq-johnny", "johnny@example.com")
+	assert.Equal(t, []Rule{RuleForbiddenSubstring}, rules(got))
+	assert.Empty(t, policy.Validate("Kj3h4vzq-johnny", "alice@example.com"))
+}
+
+func TestViolationError(t *testing.T) {
+	v := Violation{Rule: RuleMinLength, Limit: 8}
+	assert.Equal(t, "password must be at least 8 characters", v.Error())
+
+	v = Violation{Rule: RuleForbiddenSubstring, Value: "johnny"}
+	assert.Equal(t, `password must not contain "johnny"`, v.Error())
+}
@@ -0,0 +1,537 @@
+package password
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/willy182/goshare/shared"
+)
+
+const (
+	// maxEstimateLength to set the number of characters analyzed by Estimate, the rest is bruteforce
+	maxEstimateLength = 100
+	// maxWordLength to set the longest dictionary token searched
+	maxWordLength = 24
+	// minYearSpace to set the lowest guesses of a year close to the current year
+	minYearSpace = 20
+)
+
+// Pattern kind of guessable pattern matched by Estimate
+type Pattern string
+
+const (
+	// PatternDictionary pattern for common password, also reversed or with l33t substitutions
+	PatternDictionary Pattern = "dictionary"
+	// PatternUserInput pattern for user input such as username or email
+	PatternUserInput Pattern = "user_input"
+	// PatternRepeat pattern for repeated character or chunk, e.g. aaaa or abcabc
+	PatternRepeat Pattern = "repeat"
+	// PatternSequence pattern for alphabet or digit sequence, e.g. abcd or 9876
+	PatternSequence Pattern = "sequence"
+	// PatternKeyboard pattern for adjacent keyboard keys, e.g. asdfgh or 1qaz
+	PatternKeyboard Pattern = "keyboard"
+	// PatternDate pattern for year or date, e.g. 1990 or 17-08-1945
+	PatternDate Pattern = "date"
+	// PatternBruteforce pattern for characters not matched by any other pattern
+	PatternBruteforce Pattern = "bruteforce"
+)
+
+// Match data structure of a guessable token, Start and End are character (rune) offsets
+type Match struct {
+	Pattern Pattern
+	Token   string
+	Start   int
+	End     int
+	Guesses float64
+}
+
+// Strength data structure of a password strength estimate,
+// Score is 0 (too guessable) to 4 (very unguessable), Entropy is log2 of Guesses
+// and Matches is the sequence of tokens an attacker would guess with the least effort
+type Strength struct {
+	Score   int
+	Guesses float64
+	Entropy float64
+	Matches []Match
+}
+
+var (
+	// keyboardLines lines of adjacent keys on a qwerty keyboard, rows and columns
+	keyboardLines = []string{
+		"`1234567890-=", "qwertyuiop[]\\", "asdfghjkl;'", "zxcvbnm,./",
+		"1qaz", "2wsx", "3edc", "4rfv", "5tgb", "6yhn", "7ujm", "8ik,", "9ol.", "0p;/",
+	}
+
+	// l33tTable common substitutions of letters, every character maps to its likely letters
+	l33tTable = map[rune][]rune{
+		'4': {'a'}, '@': {'a'}, '8': {'b'}, '(': {'c'}, '3': {'e'}, '6': {'g'}, '9': {'g'},
+		'1': {'i', 'l'}, '!': {'i'}, '|': {'i', 'l'}, '0': {'o'}, '$': {'s'}, '5': {'s'},
+		'7': {'t'}, '+': {'t'}, '2': {'z'}, '%': {'x'},
+	}
+
+	// dictionary ranked common passwords, built on first use
+	dictionary     map[string]int
+	dictionaryOnce sync.Once
+)
+
+// Estimate function for estimating how many guesses an attacker needs to find password,
+// in the spirit of zxcvbn, userInputs such as username or email are treated as the most likely words
+func Estimate(password string, userInputs ...string) Strength {
+	dictionaryOnce.Do(loadDictionary)
+
+	rs := []rune(password)
+	extra := 0
+	if len(rs) > maxEstimateLength {
+		extra = len(rs) - maxEstimateLength
+		rs = rs[:maxEstimateLength]
+	}
+
+	inputs := make(map[string]int)
+	for i, input := range expandUserInputs(userInputs) {
+		if _, ok := inputs[strings.ToLower(input)]; !ok {
+			inputs[strings.ToLower(input)] = i + 1
+		}
+	}
+
+	matches := make([]Match, 0)
+	matches = append(matches, dictionaryMatches(rs, dictionary, PatternDictionary)...)
+	matches = append(matches, dictionaryMatches(rs, inputs, PatternUserInput)...)
+	matches = append(matches, repeatMatches(rs)...)
+	matches = append(matches, sequenceMatches(rs)...)
+	matches = append(matches, keyboardMatches(rs)...)
+	matches = append(matches, dateMatches(rs)...)
+
+	strength := mostGuessableSequence(rs, matches)
+	strength.Guesses *= math.Pow(10, float64(extra))
+	strength.Entropy = math.Log2(strength.Guesses)
+	strength.Score = guessesToScore(strength.Guesses)
+
+	return strength
+}
+
+func loadDictionary() {
+	dictionary = make(map[string]int, len(commonPasswords))
+	for i, word := range commonPasswords {
+		if _, ok := dictionary[word]; !ok {
+			dictionary[word] = i + 1
+		}
+	}
+}
+
+// isCommonPassword function for checking password is in the common password dictionary
+func isCommonPassword(password string) bool {
+	dictionaryOnce.Do(loadDictionary)
+	_, ok := dictionary[strings.ToLower(password)]
+	return ok
+}
+
+// guessesToScore function for converting guesses into a 0 to 4 score
+func guessesToScore(guesses float64) int {
+	const delta = 5
+	switch {
+	case guesses < 1e3+delta:
+		return 0
+	case guesses < 1e6+delta:
+		return 1
+	case guesses < 1e8+delta:
+		return 2
+	case guesses < 1e10+delta:
+		return 3
+	}
+	return 4
+}
+
+// mostGuessableSequence function for finding the sequence of non overlapping matches and
+// bruteforce characters covering password with the least guesses
+func mostGuessableSequence(rs []rune, matches []Match) Strength {
+	n := len(rs)
+	if n == 0 {
+		return Strength{Guesses: 1, Matches: []Match{}}
+	}
+
+	byEnd := make([][]Match, n+1)
+	for _, m := range matches {
+		byEnd[m.End] = append(byEnd[m.End], m)
+	}
+
+	guesses := make([]float64, n+1)
+	chosen := make([]*Match, n+1)
+	guesses[0] = 1
+	for end := 1; end <= n; end++ {
+		guesses[end] = guesses[end-1] * cardinality(rs[end-1])
+		chosen[end] = nil
+		for i := range byEnd[end] {
+			m := &byEnd[end][i]
+			if g := guesses[m.Start] * math.Max(m.Guesses, minGuesses(m)); g < guesses[end] {
+				guesses[end] = g
+				chosen[end] = m
+			}
+		}
+	}
+
+	sequence := make([]Match, 0)
+	for end := n; end > 0; {
+		if m := chosen[end]; m != nil {
+			sequence = append(sequence, *m)
+			end = m.Start
+			continue
+		}
+
+		start := end - 1
+		for start > 0 && chosen[start] == nil {
+			start--
+		}
+		sequence = append(sequence, Match{
+			Pattern: PatternBruteforce,
+			Token:   string(rs[start:end]),
+			Start:   start,
+			End:     end,
+			Guesses: guesses[end] / guesses[start],
+		})
+		end = start
+	}
+
+	for i, j := 0, len(sequence)-1; i < j; i, j = i+1, j-1 {
+		sequence[i], sequence[j] = sequence[j], sequence[i]
+	}
+
+	return Strength{Guesses: guesses[n], Matches: sequence}
+}
+
+// minGuesses function for getting the lowest guesses of a match so a split into
+// many tiny matches is not cheaper than bruteforce
+func minGuesses(m *Match) float64 {
+	if m.End-m.Start == 1 {
+		return 10
+	}
+	return 50
+}
+
+// cardinality function for getting the number of possible characters of the class of r
+func cardinality(r rune) float64 {
+	switch {
+	case shared.IsNumeric(r):
+		return 10
+	case shared.IsLowercase(r), shared.IsUppercase(r):
+		return 26
+	case shared.IsAllowedSymbol(r):
+		return 33
+	}
+	return 100
+}
+
+// dictionaryMatches function for finding words of dict in password, as is, reversed and with l33t substitutions
+func dictionaryMatches(rs []rune, dict map[string]int, pattern Pattern) []Match {
+	matches := make([]Match, 0)
+	if len(dict) == 0 {
+		return matches
+	}
+
+	for i := 0; i < len(rs); i++ {
+		for j := i + 3; j <= len(rs) && j-i <= maxWordLength; j++ {
+			token := rs[i:j]
+			lower := []rune(strings.ToLower(string(token)))
+			variations := uppercaseVariations(token)
+
+			best := math.Inf(1)
+			if rank, ok := dict[string(lower)]; ok {
+				best = float64(rank) * variations
+			}
+			if rank, ok := dict[string(reverseRunes(lower))]; ok {
+				best = math.Min(best, float64(rank)*variations*2)
+			}
+			for _, unleeted := range unleet(lower) {
+				if rank, ok := dict[string(unleeted)]; ok {
+					best = math.Min(best, float64(rank)*variations*l33tVariations(lower, unleeted))
+				}
+			}
+
+			if !math.IsInf(best, 1) {
+				matches = append(matches, Match{Pattern: pattern, Token: string(token), Start: i, End: j, Guesses: best})
+			}
+		}
+	}
+
+	return matches
+}
+
+// uppercaseVariations function for getting the number of ways the letters of token could be capitalized
+func uppercaseVariations(token []rune) float64 {
+	var upper, lower int
+	for _, r := range token {
+		if unicode.IsUpper(r) {
+			upper++
+		} else if unicode.IsLower(r) {
+			lower++
+		}
+	}
+
+	switch {
+	case upper == 0:
+		return 1
+	case lower == 0, upper == 1 && (unicode.IsUpper(token[0]) || unicode.IsUpper(token[len(token)-1])):
+		return 2
+	}
+
+	var variations float64
+	for i := 1; i <= upper && i <= lower; i++ {
+		variations += binomial(upper+lower, i)
+	}
+	return variations
+}
+
+// l33tVariations function for getting the number of ways the substitutions of token could be chosen
+func l33tVariations(token, unleeted []rune) float64 {
+	var subs int
+	for i := range token {
+		if token[i] != unleeted[i] {
+			subs++
+		}
+	}
+	return math.Pow(2, float64(subs))
+}
+
+// unleet function for getting the candidate words of token with l33t characters replaced by letters
+func unleet(token []rune) [][]rune {
+	candidates := make([][]rune, 0, 2)
+	for variant := 0; variant < 2; variant++ {
+		unleeted := make([]rune, len(token))
+		changed, ambiguous := false, false
+		for i, r := range token {
+			unleeted[i] = r
+			if letters, ok := l33tTable[r]; ok {
+				unleeted[i] = letters[0]
+				if len(letters) > 1 {
+					ambiguous = true
+					unleeted[i] = letters[variant%len(letters)]
+				}
+				changed = true
+			}
+		}
+		if changed {
+			candidates = append(candidates, unleeted)
+		}
+		if !ambiguous {
+			break
+		}
+	}
+	return candidates
+}
+
+// repeatMatches function for finding a character or chunk repeated at least twice, e.g. aaa or abab
+func repeatMatches(rs []rune) []Match {
+	matches := make([]Match, 0)
+	for i := 0; i < len(rs); i++ {
+		for size := 1; i+size*2 <= len(rs); size++ {
+			base := rs[i : i+size]
+			count := 1
+			for j := i + size; j+size <= len(rs) && string(rs[j:j+size]) == string(base); j += size {
+				count++
+			}
+			if count < 2 || size*count < 3 {
+				continue
+			}
+
+			baseGuesses := 1.0
+			for _, r := range base {
+				baseGuesses *= cardinality(r)
+			}
+			if rank, ok := dictionary[strings.ToLower(string(base))]; ok && float64(rank) < baseGuesses {
+				baseGuesses = float64(rank)
+			}
+
+			matches = append(matches, Match{
+				Pattern: PatternRepeat,
+				Token:   string(rs[i : i+size*count]),
+				Start:   i,
+				End:     i + size*count,
+				Guesses: baseGuesses * float64(count),
+			})
+		}
+	}
+	return matches
+}
+
+// sequenceMatches function for finding runs of consecutive letters or digits, e.g. abcd or 4321
+func sequenceMatches(rs []rune) []Match {
+	matches := make([]Match, 0)
+	for i := 0; i+2 < len(rs); {
+		delta := rs[i+1] - rs[i]
+		if (delta != 1 && delta != -1) || !sameClass(rs[i], rs[i+1]) {
+			i++
+			continue
+		}
+
+		j := i + 2
+		for j < len(rs) && rs[j]-rs[j-1] == delta && sameClass(rs[j-1], rs[j]) {
+			j++
+		}
+
+		if j-i >= 3 {
+			base := 26.0
+			switch {
+			case strings.ContainsRune("aAzZ019", rs[i]):
+				base = 4
+			case shared.IsNumeric(rs[i]):
+				base = 10
+			}
+			if delta < 0 {
+				base *= 2
+			}
+			matches = append(matches, Match{
+				Pattern: PatternSequence,
+				Token:   string(rs[i:j]),
+				Start:   i,
+				End:     j,
+				Guesses: base * float64(j-i),
+			})
+		}
+		i = j - 1
+	}
+	return matches
+}
+
+func sameClass(a, b rune) bool {
+	return shared.IsNumeric(a) && shared.IsNumeric(b) ||
+		shared.IsLowercase(a) && shared.IsLowercase(b) ||
+		shared.IsUppercase(a) && shared.IsUppercase(b)
+}
+
+// keyboardMatches function for finding runs of adjacent keyboard keys, forward or backward
+func keyboardMatches(rs []rune) []Match {
+	lower := []rune(strings.ToLower(string(rs)))
+	matches := make([]Match, 0)
+	for _, line := range keyboardLines {
+		for _, keys := range []string{line, string(reverseRunes([]rune(line)))} {
+			for i := 0; i < len(lower); i++ {
+				j := i
+				for j < len(lower) && strings.Contains(keys, string(lower[i:j+1])) {
+					j++
+				}
+				if j-i >= 4 {
+					guesses := 47 * float64(j-i)
+					if keys != line {
+						guesses *= 2
+					}
+					matches = append(matches, Match{
+						Pattern: PatternKeyboard,
+						Token:   string(rs[i:j]),
+						Start:   i,
+						End:     j,
+						Guesses: guesses * uppercaseVariations(rs[i:j]),
+					})
+				}
+				if j > i+1 {
+					i = j - 1
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// dateMatches function for finding years and dates, with or without separators
+func dateMatches(rs []rune) []Match {
+	referenceYear := time.Now().Year()
+	matches := make([]Match, 0)
+	for i := 0; i < len(rs); i++ {
+		for j := i + 4; j <= len(rs) && j-i <= 10; j++ {
+			year, full, ok := parseDate(string(rs[i:j]))
+			if !ok {
+				continue
+			}
+
+			guesses := math.Max(math.Abs(float64(year-referenceYear)), minYearSpace)
+			if full {
+				guesses *= 365
+			}
+			matches = append(matches, Match{Pattern: PatternDate, Token: string(rs[i:j]), Start: i, End: j, Guesses: guesses})
+		}
+	}
+	return matches
+}
+
+// parseDate function for getting the year of a year or day month year token,
+// full is true when the token also holds a day and month
+func parseDate(token string) (year int, full bool, ok bool) {
+	var parts []string
+	if shared.ValidateNumeric(token) {
+		switch len(token) {
+		case 4:
+			year = atoi(token)
+			return year, false, year >= 1900 && year <= 2099
+		case 6:
+			parts = []string{token[:2], token[2:4], token[4:]}
+		case 8:
+			if y := atoi(token[:4]); y >= 1900 && y <= 2099 {
+				parts = []string{token[:4], token[4:6], token[6:]}
+			} else {
+				parts = []string{token[:2], token[2:4], token[4:]}
+			}
+		default:
+			return 0, false, false
+		}
+	} else {
+		parts = strings.FieldsFunc(token, func(r rune) bool { return strings.ContainsRune(" /\\_.-", r) })
+		if len(parts) != 3 || strings.Trim(token, " /\\_.-") != token {
+			return 0, false, false
+		}
+		for _, part := range parts {
+			if !shared.ValidateNumeric(part) || len(part) > 4 {
+				return 0, false, false
+			}
+		}
+	}
+
+	// year first or year last, day and month in either order
+	for _, order := range [][3]int{{2, 0, 1}, {0, 1, 2}} {
+		y, a, b := parts[order[0]], atoi(parts[order[1]]), atoi(parts[order[2]])
+		if !(a >= 1 && a <= 31 && b >= 1 && b <= 12 || a >= 1 && a <= 12 && b >= 1 && b <= 31) {
+			continue
+		}
+		switch len(y) {
+		case 2:
+			year = atoi(y) + 1900
+			if year < 1950 {
+				year += 100
+			}
+			return year, true, true
+		case 4:
+			year = atoi(y)
+			if year >= 1900 && year <= 2099 {
+				return year, true, true
+			}
+		}
+	}
+	return 0, false, false
+}
+
+func atoi(str string) int {
+	var n int
+	for _, r := range str {
+		n = n*10 + int(r-'0')
+	}
+	return n
+}
+
+func reverseRunes(rs []rune) []rune {
+	reversed := make([]rune, len(rs))
+	for i, r := range rs {
+		reversed[len(rs)-1-i] = r
+	}
+	return reversed
+}
+
+func binomial(n, k int) float64 {
+	if k > n {
+		return 0
+	}
+	result := 1.0
+	for i := 1; i <= k; i++ {
+		result = result * float64(n-k+i) / float64(i)
+	}
+	return result
+}
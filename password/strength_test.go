@@ -0,0 +1,40 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		name       string
+		password   string
+		userInputs []string
+		score      int
+		pattern    Pattern
+	}{
+		{name: "common password", password: "password", score: 0, pattern: PatternDictionary},
+		{name: "l33t common password", password: "P@ssw0rd", score: 0, pattern: PatternDictionary},
+		{name: "reversed common password", password: "nogard", score: 0, pattern: PatternDictionary},
+		{name: "repeat", password: "aaaaaaaa", score: 0, pattern: PatternRepeat},
+		{name: "sequence", password: "lmnopqrs", score: 0, pattern: PatternSequence},
+		{name: "keyboard", password: "hjkl;'", score: 0, pattern: PatternKeyboard},
+		{name: "date", password: "17-08-1945", score: 1, pattern: PatternDate},
+		{name: "user input", password: "willy182", userInputs: []string{"willy182@example.com"}, score: 0, pattern: PatternUserInput},
+		{name: "random", password: "Xk9#mQ2$vL7!pR", score: 4, pattern: PatternBruteforce},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Estimate(tt.password, tt.userInputs...)
+			assert.Equal(t, tt.score, got.Score)
+			assert.NotEmpty(t, got.Matches)
+			assert.Equal(t, tt.pattern, got.Matches[0].Pattern)
+			assert.Equal(t, 0, got.Matches[0].Start)
+			assert.Equal(t, len([]rune(tt.password)), got.Matches[len(got.Matches)-1].End)
+		})
+	}
+
+	assert.Equal(t, 0, Estimate("").Score)
+	assert.Greater(t, Estimate("correcthorsebatterystaple").Entropy, 60.0)
+}
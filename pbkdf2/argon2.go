@@ -0,0 +1,164 @@
+package pbkdf2
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"strconv"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// Argon2Time to set the argon2id number of passes
+	Argon2Time = 2
+	// Argon2Memory to set the argon2id memory cost in KiB
+	Argon2Memory = 19 * 1024
+	// Argon2Threads to set the argon2id degree of parallelism
+	Argon2Threads = 1
+
+	// Argon2MaxTime to set the highest argon2id number of passes accepted from a stored hash
+	Argon2MaxTime = 64
+	// Argon2MaxMemory to set the highest argon2id memory cost in KiB accepted from a stored hash
+	Argon2MaxMemory = 1024 * 1024
+	// Argon2MaxThreads to set the highest argon2id degree of parallelism accepted from a stored hash
+	Argon2MaxThreads = 64
+)
+
+// Argon2idHasher data structure
+type Argon2idHasher struct {
+	saltSize int
+	keyLen   uint32
+	time     uint32
+	memory   uint32
+	threads  uint8
+}
+
+// NewArgon2idHasher function for creating argon2id hashing service, ErrInvalidSize is returned for
+// a non positive salt size or key length and ErrIterationsOutOfBounds for a cost Verify would reject
+func NewArgon2idHasher(saltSize, keyLen int, time, memory uint32, threads uint8) (*Argon2idHasher, error) {
+	if saltSize < 1 || keyLen < 1 {
+		return nil, ErrInvalidSize
+	}
+	if !validArgon2Cost(uint64(time), uint64(memory), uint64(threads)) {
+		return nil, ErrIterationsOutOfBounds
+	}
+
+	return &Argon2idHasher{
+		saltSize: saltSize,
+		keyLen:   uint32(keyLen),
+		time:     time,
+		memory:   memory,
+		threads:  threads,
+	}, nil
+}
+
+// ID method for getting the algorithm identifier
+func (h *Argon2idHasher) ID() string {
+	return "argon2id"
+}
+
+// Encode method for hashing password into a PHC string format value,
+// e.g. $argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>
+func (h *Argon2idHasher) Encode(password string) (string, error) {
+	salt := make([]byte, h.saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	p := &phc{
+		id:      h.ID(),
+		version: strconv.Itoa(argon2.Version),
+		params: map[string]string{
+			"m": strconv.FormatUint(uint64(h.memory), 10),
+			"t": strconv.FormatUint(uint64(h.time), 10),
+			"p": strconv.FormatUint(uint64(h.threads), 10),
+		},
+		salt: salt,
+		hash: argon2.IDKey([]byte(password), salt, h.time, h.memory, h.threads, h.keyLen),
+	}
+
+	return p.format("m", "t", "p"), nil
+}
+
+// Verify method for checking password against an argon2id PHC string
+func (h *Argon2idHasher) Verify(password, encoded string) (bool, error) {
+	p, err := parseArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	df := argon2.IDKey([]byte(password), p.salt, p.time, p.memory, p.threads, uint32(len(p.hash)))
+	if subtle.ConstantTimeCompare(df, p.hash) != 1 {
+		return false, ErrMismatchedPassword
+	}
+
+	return true, nil
+}
+
+// NeedsRehash method for checking whether an encoded hash is below the hasher policy
+func (h *Argon2idHasher) NeedsRehash(encoded string) bool {
+	p, err := parseArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+
+	return p.time < h.time ||
+		p.memory < h.memory ||
+		p.threads != h.threads ||
+		len(p.salt) < h.saltSize ||
+		uint32(len(p.hash)) != h.keyLen
+}
+
+// argon2idParams data structure of a decoded argon2id PHC string
+type argon2idParams struct {
+	*phc
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+// parseArgon2id function for parsing and validating an argon2id PHC string
+func parseArgon2id(encoded string) (*argon2idParams, error) {
+	p, err := parsePHC(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.id != "argon2id" || p.version != strconv.Itoa(argon2.Version) {
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	params := &argon2idParams{phc: p}
+
+	memory, err := strconv.ParseUint(p.params["m"], 10, 32)
+	if err != nil || memory == 0 {
+		return nil, ErrMalformedHash
+	}
+	time, err := strconv.ParseUint(p.params["t"], 10, 32)
+	if err != nil {
+		return nil, ErrMalformedHash
+	}
+	threads, err := strconv.ParseUint(p.params["p"], 10, 8)
+	if err != nil || threads == 0 {
+		return nil, ErrMalformedHash
+	}
+	if !validArgon2Cost(time, memory, threads) {
+		return nil, ErrIterationsOutOfBounds
+	}
+
+	if len(p.salt) == 0 || len(p.hash) == 0 {
+		return nil, ErrMalformedHash
+	}
+
+	params.memory, params.time, params.threads = uint32(memory), uint32(time), uint8(threads)
+
+	return params, nil
+}
+
+// validArgon2Cost function for checking argon2id cost within 1 and the Argon2Max bounds,
+// memory must be at least 8 KiB per thread
+func validArgon2Cost(time, memory, threads uint64) bool {
+	return time >= 1 && time <= Argon2MaxTime &&
+		threads >= 1 && threads <= Argon2MaxThreads &&
+		memory >= 8*threads && memory <= Argon2MaxMemory
+}
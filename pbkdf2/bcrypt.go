@@ -0,0 +1,62 @@
+package pbkdf2
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptHasher data structure
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher function for creating bcrypt hashing service
+func NewBcryptHasher(cost int) *BcryptHasher {
+	return &BcryptHasher{cost: cost}
+}
+
+// ID method for getting the algorithm identifier
+func (h *BcryptHasher) ID() string {
+	return "bcrypt"
+}
+
+// Encode method for hashing password into a modular crypt format value, e.g. $2a$10$<salt+hash>
+func (h *BcryptHasher) Encode(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hashed), nil
+}
+
+// Verify method for checking password against a bcrypt hash
+func (h *BcryptHasher) Verify(password, encoded string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err == nil {
+		return true, nil
+	}
+
+	switch err.(type) {
+	case bcrypt.InvalidCostError:
+		return false, ErrIterationsOutOfBounds
+	case bcrypt.HashVersionTooNewError, bcrypt.InvalidHashPrefixError:
+		return false, ErrUnsupportedAlgorithm
+	}
+
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, ErrMismatchedPassword
+	}
+
+	return false, ErrMalformedHash
+}
+
+// NeedsRehash method for checking whether a bcrypt hash cost is below the hasher cost
+func (h *BcryptHasher) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return true
+	}
+	return cost < h.cost
+}
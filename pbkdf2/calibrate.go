@@ -0,0 +1,106 @@
+package pbkdf2
+
+import (
+	"errors"
+	"hash"
+	"time"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// RecommendedIterationsSHA1 to set the OWASP recommended minimum iteration for PBKDF2-HMAC-SHA1
+	RecommendedIterationsSHA1 = 1300000
+	// RecommendedIterationsSHA256 to set the OWASP recommended minimum iteration for PBKDF2-HMAC-SHA256
+	RecommendedIterationsSHA256 = 600000
+	// RecommendedIterationsSHA512 to set the OWASP recommended minimum iteration for PBKDF2-HMAC-SHA512
+	RecommendedIterationsSHA512 = 210000
+
+	// calibrationStart to set the iteration of the first calibration round
+	calibrationStart = 1000
+	// calibrationRounds to set the number of measurements, the fastest one is used
+	calibrationRounds = 3
+)
+
+// ErrInvalidTarget variable for error of non positive calibration target duration
+var ErrInvalidTarget = errors.New("calibration target must be positive")
+
+// recommendedIterations OWASP recommended minimum iteration by PHC algorithm name
+var recommendedIterations = map[string]int{
+	"sha1":       RecommendedIterationsSHA1,
+	"sha224":     RecommendedIterationsSHA256,
+	"sha256":     RecommendedIterationsSHA256,
+	"sha384":     RecommendedIterationsSHA512,
+	"sha512":     RecommendedIterationsSHA512,
+	"sha512-224": RecommendedIterationsSHA512,
+	"sha512-256": RecommendedIterationsSHA512,
+}
+
+// RecommendedIterations function for getting the minimum iteration recommended for hash,
+// 0 is returned for hash functions without a recommendation such as md5 or sha3
+func RecommendedIterations(hash func() hash.Hash) int {
+	name, _ := hashName(hash)
+	return recommendedIterations[name]
+}
+
+// Calibrate function for measuring the PBKDF2 iteration of hash and keyLen that takes about
+// target on the running hardware, e.g. at startup before calling NewPBKDF2Hasher.
+// The result is raised to floor when the measured iteration is lower, pass RecommendedIterations
+// of hash to enforce the recommended minimum. MinIterations and MaxIterations win over floor
+func Calibrate(hash func() hash.Hash, keyLen int, target time.Duration, floor int) (int, error) {
+	if target <= 0 {
+		return 0, ErrInvalidTarget
+	}
+	if _, ok := hashName(hash); !ok {
+		return 0, ErrUnsupportedAlgorithm
+	}
+
+	return calibrate(hash, keyLen, target, floor), nil
+}
+
+// calibrate function for measuring the iteration of hash and keyLen that takes about target, bounded by floor
+func calibrate(hash func() hash.Hash, keyLen int, target time.Duration, floor int) int {
+	password := []byte("calibration password")
+	salt := make([]byte, SaltSize)
+
+	// grow the iteration until a round is long enough to be measured reliably
+	iteration := calibrationStart
+	elapsed := measure(password, salt, iteration, keyLen, hash)
+	for elapsed < target/10 && elapsed < 50*time.Millisecond && iteration < MaxIterations {
+		iteration *= 4
+		elapsed = measure(password, salt, iteration, keyLen, hash)
+	}
+
+	for i := 1; i < calibrationRounds; i++ {
+		if d := measure(password, salt, iteration, keyLen, hash); d < elapsed {
+			elapsed = d
+		}
+	}
+
+	result := MaxIterations
+	if elapsed > 0 {
+		result = int(float64(iteration) * float64(target) / float64(elapsed))
+	}
+
+	return boundIterations(result, floor)
+}
+
+// boundIterations function for keeping iteration within floor, MinIterations and MaxIterations
+func boundIterations(iteration, floor int) int {
+	if iteration < floor {
+		iteration = floor
+	}
+	if iteration < MinIterations {
+		iteration = MinIterations
+	}
+	if iteration > MaxIterations {
+		iteration = MaxIterations
+	}
+	return iteration
+}
+
+func measure(password, salt []byte, iteration, keyLen int, hash func() hash.Hash) time.Duration {
+	start := time.Now()
+	pbkdf2.Key(password, salt, iteration, keyLen, hash)
+	return time.Since(start)
+}
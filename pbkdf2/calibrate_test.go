@@ -0,0 +1,62 @@
+package pbkdf2
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
+)
+
+func TestRecommendedIterations(t *testing.T) {
+	assert.Equal(t, RecommendedIterationsSHA1, RecommendedIterations(sha1.New))
+	assert.Equal(t, RecommendedIterationsSHA256, RecommendedIterations(sha256.New))
+	assert.Equal(t, RecommendedIterationsSHA512, RecommendedIterations(sha512.New))
+	assert.Equal(t, 0, RecommendedIterations(sha3.New256))
+	assert.Equal(t, 0, RecommendedIterations(md5.New))
+}
+
+func TestCalibrate(t *testing.T) {
+	t.Run("scale with target", func(t *testing.T) {
+		short := calibrate(sha256.New, 32, 5*time.Millisecond, MinIterations)
+		long := calibrate(sha256.New, 32, 50*time.Millisecond, MinIterations)
+
+		assert.GreaterOrEqual(t, short, MinIterations)
+		assert.Greater(t, long, short)
+	})
+
+	t.Run("enforce floor", func(t *testing.T) {
+		iteration, err := Calibrate(sha512.New, 64, time.Microsecond, RecommendedIterations(sha512.New))
+		assert.NoError(t, err)
+		assert.Equal(t, RecommendedIterationsSHA512, iteration)
+
+		// an explicit floor below the recommended minimum replaces it
+		iteration, err = Calibrate(sha512.New, 64, time.Microsecond, 2*MinIterations)
+		assert.NoError(t, err)
+		assert.Equal(t, 2*MinIterations, iteration)
+
+		// hashes without a recommendation only get the explicit floor
+		iteration, err = Calibrate(md5.New, 16, time.Microsecond, RecommendedIterations(md5.New))
+		assert.NoError(t, err)
+		assert.Equal(t, MinIterations, iteration)
+
+		iteration, err = Calibrate(sha512.New, 64, time.Microsecond, MaxIterations+1)
+		assert.NoError(t, err)
+		assert.Equal(t, MaxIterations, iteration)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := Calibrate(sha256.New, 32, 0, MinIterations)
+		assert.Equal(t, ErrInvalidTarget, err)
+
+		_, err = Calibrate(nil, 32, time.Millisecond, MinIterations)
+		assert.Equal(t, ErrUnsupportedAlgorithm, err)
+	})
+
+	assert.Equal(t, MinIterations, boundIterations(1, 0))
+	assert.Equal(t, MaxIterations, boundIterations(MaxIterations+1, 0))
+}
@@ -0,0 +1,265 @@
+package pbkdf2
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Cipher AEAD algorithm used for sealing an envelope
+type Cipher byte
+
+const (
+	// AES256GCM cipher for AES-256 in GCM mode
+	AES256GCM Cipher = 1
+	// ChaCha20Poly1305 cipher for ChaCha20-Poly1305
+	ChaCha20Poly1305 Cipher = 2
+)
+
+const (
+	// DefaultChunkSize to set the plaintext size of every encrypted chunk
+	DefaultChunkSize = 64 * 1024
+	// MaxChunkSize to set the highest chunk size accepted from an envelope header
+	MaxChunkSize = 16 * 1024 * 1024
+
+	envelopeVersion  = 1
+	envelopeSaltSize = 16
+	envelopeKeySize  = 32
+	// noncePrefixSize random part of the 12 bytes nonce, followed by a 4 bytes chunk counter and a last chunk flag
+	noncePrefixSize = 7
+)
+
+// envelopeMagic first bytes of every envelope
+var envelopeMagic = []byte("GSPB")
+
+var (
+	// ErrMalformedEnvelope variable for error of envelope header or chunk format
+	ErrMalformedEnvelope = errors.New("malformed envelope")
+	// ErrDecryption variable for error of wrong passphrase or tampered, truncated envelope
+	ErrDecryption = errors.New("envelope authentication failed")
+)
+
+// DeriveKey function for deriving a key of keyLen from passphrase and salt with PBKDF2-HMAC-SHA256
+func DeriveKey(passphrase, salt []byte, iteration, keyLen int) ([]byte, error) {
+	if iteration < MinIterations || iteration > MaxIterations {
+		return nil, ErrIterationsOutOfBounds
+	}
+	if len(salt) == 0 {
+		return nil, ErrMalformedSalt
+	}
+	return pbkdf2.Key(passphrase, salt, iteration, keyLen, sha256.New), nil
+}
+
+// Encryptor data structure for sealing data with a passphrase into a versioned envelope,
+// the header stores the cipher, iteration, chunk size, salt and nonce so Open and
+// DecryptStream need only the passphrase
+//
+// The envelope layout is:
+// magic(4) | version(1) | cipher(1) | iteration(4) | chunk size(4) | salt(16) | nonce prefix(7) | chunks...
+// and every chunk is sealed with the header as additional data
+type Encryptor struct {
+	cipher    Cipher
+	iteration int
+	chunkSize int
+}
+
+// NewEncryptor function for creating envelope encryption service, a chunkSize lower than 1 uses DefaultChunkSize
+func NewEncryptor(cipher Cipher, iteration, chunkSize int) *Encryptor {
+	if chunkSize < 1 {
+		chunkSize = DefaultChunkSize
+	}
+	return &Encryptor{
+		cipher:    cipher,
+		iteration: iteration,
+		chunkSize: chunkSize,
+	}
+}
+
+// Seal method for encrypting plaintext with passphrase into an envelope
+func (e *Encryptor) Seal(passphrase, plaintext []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := e.EncryptStream(&buf, bytes.NewReader(plaintext), passphrase); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Open function for decrypting an envelope created by Seal or EncryptStream
+func Open(passphrase, envelope []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := DecryptStream(&buf, bytes.NewReader(envelope), passphrase); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// EncryptStream method for encrypting src chunk by chunk with passphrase into an envelope written to dst
+func (e *Encryptor) EncryptStream(dst io.Writer, src io.Reader, passphrase []byte) error {
+	if e.chunkSize > MaxChunkSize {
+		return ErrMalformedEnvelope
+	}
+
+	header := make([]byte, 0, envelopeHeaderSize)
+	header = append(header, envelopeMagic...)
+	header = append(header, envelopeVersion, byte(e.cipher))
+	header = appendUint32(header, uint32(e.iteration))
+	header = appendUint32(header, uint32(e.chunkSize))
+
+	random := make([]byte, envelopeSaltSize+noncePrefixSize)
+	if _, err := rand.Read(random); err != nil {
+		return err
+	}
+	header = append(header, random...)
+
+	aead, err := envelopeAEAD(e.cipher, passphrase, header)
+	if err != nil {
+		return err
+	}
+
+	if _, err := dst.Write(header); err != nil {
+		return err
+	}
+
+	r := bufio.NewReader(src)
+	plaintext := make([]byte, e.chunkSize)
+	ciphertext := make([]byte, 0, e.chunkSize+aead.Overhead())
+	for counter := uint32(0); ; counter++ {
+		n, err := io.ReadFull(r, plaintext)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+
+		last := err != nil
+		if !last {
+			if _, err := r.Peek(1); err == io.EOF {
+				last = true
+			}
+		}
+
+		ciphertext = aead.Seal(ciphertext[:0], chunkNonce(header, counter, last), plaintext[:n], header)
+		if _, err := dst.Write(ciphertext); err != nil {
+			return err
+		}
+
+		if last {
+			return nil
+		}
+		if counter == ^uint32(0) {
+			return ErrMalformedEnvelope
+		}
+	}
+}
+
+// DecryptStream function for decrypting an envelope from src chunk by chunk and writing the plaintext to dst,
+// every chunk is authenticated before it is written but a truncated envelope is only detected at the end
+func DecryptStream(dst io.Writer, src io.Reader, passphrase []byte) error {
+	header := make([]byte, envelopeHeaderSize)
+	if _, err := io.ReadFull(src, header); err != nil {
+		return ErrMalformedEnvelope
+	}
+
+	if !bytes.Equal(header[:len(envelopeMagic)], envelopeMagic) {
+		return ErrMalformedEnvelope
+	}
+	if header[4] != envelopeVersion {
+		return ErrUnsupportedAlgorithm
+	}
+
+	chunkSize := binary.BigEndian.Uint32(header[10:14])
+	if chunkSize < 1 || chunkSize > MaxChunkSize {
+		return ErrMalformedEnvelope
+	}
+
+	aead, err := envelopeAEAD(Cipher(header[5]), passphrase, header)
+	if err != nil {
+		return err
+	}
+
+	r := bufio.NewReader(src)
+	ciphertext := make([]byte, int(chunkSize)+aead.Overhead())
+	plaintext := make([]byte, 0, chunkSize)
+	for counter := uint32(0); ; counter++ {
+		n, err := io.ReadFull(r, ciphertext)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			if err == io.EOF {
+				return ErrDecryption
+			}
+			return err
+		}
+
+		last := err == io.ErrUnexpectedEOF
+		if !last {
+			if _, err := r.Peek(1); err == io.EOF {
+				last = true
+			}
+		}
+
+		plaintext, err = aead.Open(plaintext[:0], chunkNonce(header, counter, last), ciphertext[:n], header)
+		if err != nil {
+			return ErrDecryption
+		}
+		if _, err := dst.Write(plaintext); err != nil {
+			return err
+		}
+
+		if last {
+			return nil
+		}
+		if counter == ^uint32(0) {
+			return ErrMalformedEnvelope
+		}
+	}
+}
+
+// envelopeHeaderSize number of bytes of an envelope header
+const envelopeHeaderSize = 4 + 1 + 1 + 4 + 4 + envelopeSaltSize + noncePrefixSize
+
+// envelopeAEAD function for deriving the key of header salt and iteration and creating its cipher
+func envelopeAEAD(c Cipher, passphrase, header []byte) (cipher.AEAD, error) {
+	iteration := int(binary.BigEndian.Uint32(header[6:10]))
+	salt := header[14 : 14+envelopeSaltSize]
+
+	key, err := DeriveKey(passphrase, salt, iteration, envelopeKeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c {
+	case AES256GCM:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(block)
+	case ChaCha20Poly1305:
+		return chacha20poly1305.New(key)
+	}
+
+	return nil, ErrUnsupportedAlgorithm
+}
+
+// chunkNonce function for building the nonce of a chunk from the header nonce prefix, counter and last flag
+func chunkNonce(header []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, 0, noncePrefixSize+5)
+	nonce = append(nonce, header[envelopeHeaderSize-noncePrefixSize:]...)
+	nonce = appendUint32(nonce, counter)
+	if last {
+		return append(nonce, 1)
+	}
+	return append(nonce, 0)
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
@@ -0,0 +1,83 @@
+package pbkdf2
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncryptor(t *testing.T) {
+	passphrase := []byte("correct horse battery staple")
+	data := make([]byte, 10000)
+	rand.Read(data)
+
+	tests := []struct {
+		name      string
+		cipher    Cipher
+		chunkSize int
+		plaintext []byte
+	}{
+		{name: "aes-gcm single chunk", cipher: AES256GCM, chunkSize: 0, plaintext: data},
+		{name: "aes-gcm many chunks", cipher: AES256GCM, chunkSize: 333, plaintext: data},
+		{name: "chacha20 exact chunks", cipher: ChaCha20Poly1305, chunkSize: 1000, plaintext: data},
+		{name: "chacha20 empty", cipher: ChaCha20Poly1305, chunkSize: 1000, plaintext: []byte{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEncryptor(tt.cipher, MinIterations, tt.chunkSize)
+
+			envelope, err := e.Seal(passphrase, tt.plaintext)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("GSPB"), envelope[:4])
+
+			plaintext, err := Open(passphrase, envelope)
+			assert.NoError(t, err)
+			assert.True(t, bytes.Equal(tt.plaintext, plaintext))
+
+			var buf bytes.Buffer
+			assert.NoError(t, DecryptStream(&buf, bytes.NewReader(envelope), passphrase))
+			assert.True(t, bytes.Equal(tt.plaintext, buf.Bytes()))
+
+			_, err = Open([]byte("wrong"), envelope)
+			assert.Equal(t, ErrDecryption, err)
+		})
+	}
+}
+
+func TestEncryptorTampered(t *testing.T) {
+	passphrase := []byte("correct horse battery staple")
+	e := NewEncryptor(AES256GCM, MinIterations, 100)
+	envelope, err := e.Seal(passphrase, bytes.Repeat([]byte("goshare"), 100))
+	assert.NoError(t, err)
+
+	t.Run("truncated at chunk boundary", func(t *testing.T) {
+		chunk := 100 + 16
+		_, err := Open(passphrase, envelope[:envelopeHeaderSize+2*chunk])
+		assert.Equal(t, ErrDecryption, err)
+	})
+
+	t.Run("modified header", func(t *testing.T) {
+		modified := append([]byte(nil), envelope...)
+		modified[envelopeHeaderSize-1] ^= 1
+		_, err := Open(passphrase, modified)
+		assert.Equal(t, ErrDecryption, err)
+	})
+
+	t.Run("malformed header", func(t *testing.T) {
+		_, err := Open(passphrase, []byte("GSPB"))
+		assert.Equal(t, ErrMalformedEnvelope, err)
+
+		_, err = Open(passphrase, append([]byte("XXXX"), envelope[4:]...))
+		assert.Equal(t, ErrMalformedEnvelope, err)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		_, err := NewEncryptor(Cipher(9), MinIterations, 0).Seal(passphrase, []byte("data"))
+		assert.Equal(t, ErrUnsupportedAlgorithm, err)
+
+		_, err = NewEncryptor(AES256GCM, 1, 0).Seal(passphrase, []byte("data"))
+		assert.Equal(t, ErrIterationsOutOfBounds, err)
+	})
+}
@@ -1,11 +1,14 @@
 package pbkdf2
 
 import (
-	"bytes"
-	"crypto/rand"
+	"crypto/subtle"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"hash"
+	"strconv"
+	"strings"
+	"sync"
 
 	"golang.org/x/crypto/pbkdf2"
 )
@@ -15,9 +18,34 @@ const (
 	IterationsCount = 15000
 	// SaltSize to set salt size
 	SaltSize = 64
+	// MinIterations to set the lowest iteration accepted from a stored hash, a legacy salt is only checked
+	// against it by ConvertLegacy so that old salts with fewer iterations still verify
+	MinIterations = 1000
+	// MaxIterations to set the highest iteration accepted from a stored hash or salt
+	MaxIterations = 100000000
+)
+
+// errors returned when checking a password, ErrMismatchedPassword means the password is wrong
+// while the others mean the stored hash or salt is corrupt or can not be handled
+var (
+	// ErrMismatchedPassword variable for error of password not matching the stored hash
+	ErrMismatchedPassword = errors.New("mismatched password")
+	// ErrMalformedHash variable for error of encoded hash or cipher text format
+	ErrMalformedHash = errors.New("malformed encoded hash")
+	// ErrMalformedSalt variable for error of legacy salt format
+	ErrMalformedSalt = errors.New("malformed salt")
+	// ErrUnsupportedAlgorithm variable for error of unknown hash function or algorithm
+	ErrUnsupportedAlgorithm = errors.New("unsupported hash algorithm")
+	// ErrIterationsOutOfBounds variable for error of iteration or cost outside the accepted range
+	ErrIterationsOutOfBounds = errors.New("iteration count out of bounds")
+	// ErrInvalidSize variable for error of non positive salt size or key length given to a hasher constructor
+	ErrInvalidSize = errors.New("invalid salt size or key length")
 )
 
 // PasswordHasher interface abstraction
+//
+// Deprecated: the salt set by ParseSalt is shared state, use Hasher for
+// salt based hashing or Algorithm for encoded hashes.
 type PasswordHasher interface {
 	Hash(data []byte) []byte
 	ParseSalt(salt string) error
@@ -25,72 +53,306 @@ type PasswordHasher interface {
 	VerifyPassword(password, cipherText, salt string) bool
 }
 
-// PBKDF2Hasher data structure
+// PBKDF2Hasher data structure, Encode, Verify and NeedsRehash only read the
+// configured policy and are safe for concurrent use
 type PBKDF2Hasher struct {
+	hasher *Hasher
+
+	// pepperID, peppers and pepperErr are set by WithPepper
+	pepperID  string
+	peppers   map[string][]byte
+	pepperErr error
+
+	// mu guards the salt and iteration set by the deprecated GenerateSalt and ParseSalt
+	mu        sync.Mutex
 	salt      []byte
-	keyLen    int
-	hash      func() hash.Hash
 	iteration int
 }
 
+// Option function for configuring PBKDF2Hasher
+type Option func(*PBKDF2Hasher)
+
 // NewPBKDF2Hasher function for creating hashing service
-func NewPBKDF2Hasher(saltSize, KeyLen, iteration int, hash func() hash.Hash) *PBKDF2Hasher {
-	return &PBKDF2Hasher{
+func NewPBKDF2Hasher(saltSize, KeyLen, iteration int, hash func() hash.Hash, opts ...Option) *PBKDF2Hasher {
+	h := &PBKDF2Hasher{
+		hasher:    NewHasher(saltSize, KeyLen, iteration, hash),
 		salt:      make([]byte, saltSize),
-		keyLen:    KeyLen,
 		iteration: iteration,
-		hash:      hash,
 	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Hasher method for getting the stateless hasher of the configured policy
+func (h *PBKDF2Hasher) Hasher() *Hasher {
+	return h.hasher
 }
 
-// GenerateSalt function
+// GenerateSalt function, the generated salt is also set for Hash like ParseSalt does
+//
+// Deprecated: use Hasher().GenerateSalt or Encode.
 func (h *PBKDF2Hasher) GenerateSalt() string {
-	// initiate new salt to prevent replacing data
-	salt := h.salt
-	rand.Read(salt)
+	salt, _ := h.hasher.GenerateSalt()
+
+	h.mu.Lock()
+	h.salt = salt
+	h.iteration = h.hasher.iteration
+	h.mu.Unlock()
+
 	saltString := base64.StdEncoding.EncodeToString(salt)
 
-	combinedSalt := fmt.Sprintf("%v.%v", h.iteration, saltString)
+	combinedSalt := fmt.Sprintf("%v.%v", h.hasher.iteration, saltString)
 	return combinedSalt
 }
 
-// Hash PBKDF2Hasher function for hashing string
+// Hash PBKDF2Hasher function for hashing string with the salt set by GenerateSalt or ParseSalt
+//
+// Deprecated: use Hasher().Hash or Encode.
 func (h *PBKDF2Hasher) Hash(data []byte) []byte {
-	hashed := pbkdf2.Key(data, h.salt, h.iteration, h.keyLen, h.hash)
+	h.mu.Lock()
+	salt, iteration := h.salt, h.iteration
+	h.mu.Unlock()
 
-	// force replacing salt to prevent bigger byte
-	h.salt = make([]byte, h.keyLen)
-
-	return hashed
+	return pbkdf2.Key(data, salt, iteration, h.hasher.keyLen, h.hasher.hash)
 }
 
 // ParseSalt PBKDF2Hasher function for parsing salt
+//
+// Deprecated: use Hasher().Hash or Encode.
 func (h *PBKDF2Hasher) ParseSalt(salt string) error {
+	iteration, err := parseLegacySalt(salt, 1)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	h.iteration = iteration
+	h.salt = []byte(salt)
+	h.mu.Unlock()
+
+	return nil
+}
+
+// VerifyPassword method, the iteration is taken from the salt
+//
+// Deprecated: use Hasher().Verify or Verify.
+func (h *PBKDF2Hasher) VerifyPassword(password, cipherText, salt string) bool {
+	return h.ComparePassword(password, cipherText, salt) == nil
+}
+
+// ComparePassword method for checking password like VerifyPassword and returning ErrMismatchedPassword
+// for a wrong password or another error when the stored cipher text or salt is corrupt,
+// an encoded hash is accepted as cipher text and the salt is then ignored
+func (h *PBKDF2Hasher) ComparePassword(password, cipherText, salt string) error {
+	if strings.HasPrefix(cipherText, "$") {
+		_, err := h.Verify(password, cipherText)
+		return err
+	}
+
+	iteration, err := parseLegacySalt(salt, 1)
+	if err != nil {
+		return err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(cipherText)
+	if err != nil || len(key) == 0 {
+		return ErrMalformedHash
+	}
+
+	df := pbkdf2.Key([]byte(password), []byte(salt), iteration, h.hasher.keyLen, h.hasher.hash)
+	if subtle.ConstantTimeCompare(df, key) != 1 {
+		return ErrMismatchedPassword
+	}
+
+	return nil
+}
+
+// ID method for getting the algorithm identifier
+func (h *PBKDF2Hasher) ID() string {
+	return "pbkdf2"
+}
+
+// Encode method for hashing password with a fresh random salt into a PHC string
+// format value, e.g. $pbkdf2-sha256$i=15000,l=32$<salt>$<hash>
+func (h *PBKDF2Hasher) Encode(password string) (string, error) {
+	if h.hasher.algorithm == "" {
+		return "", ErrUnsupportedAlgorithm
+	}
+
+	peppered, err := h.pepper([]byte(password), h.pepperID, h.hasher.hash)
+	if err != nil {
+		return "", err
+	}
+
+	salt, err := h.hasher.GenerateSalt()
+	if err != nil {
+		return "", err
+	}
+
+	p := &phc{
+		id: h.ID() + "-" + h.hasher.algorithm,
+		params: map[string]string{
+			"i": strconv.Itoa(h.hasher.iteration),
+			"l": strconv.Itoa(h.hasher.keyLen),
+		},
+		salt: salt,
+		hash: h.hasher.Hash(peppered, salt),
+	}
+	if h.pepperID != "" {
+		p.params["k"] = h.pepperID
+	}
+
+	return p.format("i", "l", "k"), nil
+}
+
+// Verify method for checking password against a PHC string format value created by Encode,
+// the algorithm, iteration, key length and pepper key ID are taken from the encoded value
+func (h *PBKDF2Hasher) Verify(password, encoded string) (bool, error) {
+	p, err := parsePBKDF2(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	peppered, err := h.pepper([]byte(password), p.keyID, p.fn)
+	if err != nil {
+		return false, err
+	}
+
+	df := pbkdf2.Key(peppered, p.salt, p.iteration, len(p.hash), p.fn)
+	if subtle.ConstantTimeCompare(df, p.hash) != 1 {
+		return false, ErrMismatchedPassword
+	}
+
+	return true, nil
+}
+
+// NeedsRehash method for checking whether an encoded hash is a legacy value or below the
+// hasher policy of algorithm, iteration, salt size, key length and current pepper
+func (h *PBKDF2Hasher) NeedsRehash(encoded string) bool {
+	p, err := parsePBKDF2(encoded)
+	if err != nil {
+		return true
+	}
+
+	return p.algorithm != h.hasher.algorithm ||
+		p.keyID != h.pepperID ||
+		p.iteration < h.hasher.iteration ||
+		len(p.salt) < h.hasher.saltSize ||
+		p.keyLen != h.hasher.keyLen
+}
+
+// VerifyAndUpgrade method for checking password like VerifyPassword, an encoded hash is
+// accepted as cipher text and the salt is then ignored, when the stored value needs rehash
+// a fresh encoded hash under the current policy is returned to be persisted
+func (h *PBKDF2Hasher) VerifyAndUpgrade(password, cipherText, salt string) (bool, string, error) {
+	return verifyAndUpgrade(h, password, cipherText, salt, h.ComparePassword)
+}
+
+// ConvertLegacy method for converting a cipher text and salt pair created by the
+// PasswordHasher methods into a PHC string format value verifiable by Verify,
+// the iteration is taken from the salt
+func (h *PBKDF2Hasher) ConvertLegacy(cipherText, salt string) (string, error) {
+	if h.hasher.algorithm == "" {
+		return "", ErrUnsupportedAlgorithm
+	}
+
+	iteration, err := parseLegacySalt(salt, MinIterations)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(cipherText)
+	if err != nil || len(key) == 0 {
+		return "", ErrMalformedHash
+	}
+
+	p := &phc{
+		id: h.ID() + "-" + h.hasher.algorithm,
+		params: map[string]string{
+			"i": strconv.Itoa(iteration),
+			"l": strconv.Itoa(len(key)),
+		},
+		salt: []byte(salt),
+		hash: key,
+	}
+
+	return p.format("i", "l"), nil
+}
+
+// parseLegacySalt function for validating a salt created by GenerateSalt and getting its iteration prefix,
+// the iteration must be within minIteration and MaxIterations
+func parseLegacySalt(salt string, minIteration int) (int, error) {
 	var iteration int
 	var saltOnly string
 
-	_, err := fmt.Sscanf(salt, "%d.%s", &iteration, &saltOnly)
+	if _, err := fmt.Sscanf(salt, "%d.%s", &iteration, &saltOnly); err != nil {
+		return 0, ErrMalformedSalt
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(saltOnly); err != nil || saltOnly == "" {
+		return 0, ErrMalformedSalt
+	}
+
+	if iteration < minIteration || iteration > MaxIterations {
+		return 0, ErrIterationsOutOfBounds
+	}
+
+	return iteration, nil
+}
+
+// pbkdf2Params data structure of a decoded pbkdf2 PHC string
+type pbkdf2Params struct {
+	*phc
+	algorithm string
+	fn        func() hash.Hash
+	iteration int
+	keyLen    int
+	keyID     string
+}
 
+// parsePBKDF2 function for parsing and validating a pbkdf2 PHC string
+func parsePBKDF2(encoded string) (*pbkdf2Params, error) {
+	p, err := parsePHC(encoded)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
-	h.iteration = iteration
+	if !strings.HasPrefix(p.id, "pbkdf2-") {
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	params := &pbkdf2Params{phc: p, algorithm: strings.TrimPrefix(p.id, "pbkdf2-")}
 
-	if cap(h.salt) < len(salt) {
-		h.salt = make([]byte, len(salt))
+	var ok bool
+	if params.fn, ok = hashByName(params.algorithm); !ok {
+		return nil, ErrUnsupportedAlgorithm
 	}
 
-	copy(h.salt, []byte(salt))
+	if params.iteration, err = strconv.Atoi(p.params["i"]); err != nil {
+		return nil, ErrMalformedHash
+	}
+	if params.iteration < MinIterations || params.iteration > MaxIterations {
+		return nil, ErrIterationsOutOfBounds
+	}
 
-	return nil
-}
+	if params.keyLen, err = strconv.Atoi(p.params["l"]); err != nil || params.keyLen != len(p.hash) {
+		return nil, ErrMalformedHash
+	}
 
-// VerifyPassword method
-func (h *PBKDF2Hasher) VerifyPassword(password, cipherText, salt string) bool {
-	saltBytes := bytes.NewBufferString(salt).Bytes()
-	df := pbkdf2.Key([]byte(password), saltBytes, h.iteration, h.keyLen, h.hash)
-	newCipherText := base64.StdEncoding.EncodeToString(df)
+	if keyID, ok := p.params["k"]; ok {
+		if !validPepperID(keyID) {
+			return nil, ErrMalformedHash
+		}
+		params.keyID = keyID
+	}
+
+	if len(p.salt) == 0 || len(p.hash) == 0 {
+		return nil, ErrMalformedHash
+	}
 
-	return newCipherText == cipherText
+	return params, nil
 }
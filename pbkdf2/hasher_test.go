@@ -0,0 +1,132 @@
+package pbkdf2
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"hash"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
+)
+
+func TestPBKDF2HasherEncode(t *testing.T) {
+	tests := []struct {
+		name   string
+		hash   func() hash.Hash
+		prefix string
+	}{
+		{name: "md5", hash: md5.New, prefix: "$pbkdf2-md5$i=1000,l=32$"},
+		{name: "sha1", hash: sha1.New, prefix: "$pbkdf2-sha1$i=1000,l=32$"},
+		{name: "sha224", hash: sha256.New224, prefix: "$pbkdf2-sha224$i=1000,l=32$"},
+		{name: "sha256", hash: sha256.New, prefix: "$pbkdf2-sha256$i=1000,l=32$"},
+		{name: "sha384", hash: sha512.New384, prefix: "$pbkdf2-sha384$i=1000,l=32$"},
+		{name: "sha512", hash: sha512.New, prefix: "$pbkdf2-sha512$i=1000,l=32$"},
+		{name: "sha512-224", hash: sha512.New512_224, prefix: "$pbkdf2-sha512-224$i=1000,l=32$"},
+		{name: "sha512-256", hash: sha512.New512_256, prefix: "$pbkdf2-sha512-256$i=1000,l=32$"},
+		{name: "sha3-256", hash: sha3.New256, prefix: "$pbkdf2-sha3-256$i=1000,l=32$"},
+		{name: "keccak256", hash: sha3.NewLegacyKeccak256, prefix: "$pbkdf2-keccak256$i=1000,l=32$"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewPBKDF2Hasher(16, 32, 1000, tt.hash)
+
+			encoded, err := h.Encode("s3cret")
+			assert.NoError(t, err)
+			assert.True(t, strings.HasPrefix(encoded, tt.prefix), encoded)
+
+			ok, err := h.Verify("s3cret", encoded)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+
+			ok, err = h.Verify("wrong", encoded)
+			assert.Equal(t, ErrMismatchedPassword, err)
+			assert.False(t, ok)
+
+			// the encoded value is self describing, any hasher can verify it
+			ok, err = NewPBKDF2Hasher(SaltSize, 64, IterationsCount, sha1.New).Verify("s3cret", encoded)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestPBKDF2HasherVerifyMalformed(t *testing.T) {
+	h := NewPBKDF2Hasher(SaltSize, 32, IterationsCount, sha256.New)
+
+	for _, encoded := range []string{
+		"",
+		"pbkdf2-sha256$i=1000,l=32$c2FsdA$aGFzaA",
+		"$pbkdf2-sha256$i=abc,l=4$c2FsdA$aGFzaA",
+		"$pbkdf2-sha256$i=1000,l=32$c2FsdA$aGFzaA",
+		"$pbkdf2-sha256$i=1000,l=4$c2FsdA$!!!",
+		"$pbkdf2-sha256$i=1000,l=4$c2FsdA",
+	} {
+		_, err := h.Verify("s3cret", encoded)
+		assert.Equal(t, ErrMalformedHash, err, encoded)
+	}
+
+	_, err := h.Verify("s3cret", "$pbkdf2-sha256$i=10,l=4$c2FsdA$aGFzaA")
+	assert.Equal(t, ErrIterationsOutOfBounds, err)
+
+	_, err = h.Verify("s3cret", "$pbkdf2-whirlpool$i=1000,l=4$c2FsdA$aGFzaA")
+	assert.Equal(t, ErrUnsupportedAlgorithm, err)
+
+	_, err = NewPBKDF2Hasher(SaltSize, 32, IterationsCount, nil).Encode("s3cret")
+	assert.Equal(t, ErrUnsupportedAlgorithm, err)
+}
+
+func TestPBKDF2HasherLegacy(t *testing.T) {
+	h := NewPBKDF2Hasher(SaltSize, 32, IterationsCount, sha256.New)
+
+	salt := h.GenerateSalt()
+	assert.NoError(t, h.ParseSalt(salt))
+
+	cipherText := base64.StdEncoding.EncodeToString(h.Hash([]byte("s3cret")))
+	assert.True(t, h.VerifyPassword("s3cret", cipherText, salt))
+	assert.False(t, h.VerifyPassword("wrong", cipherText, salt))
+}
+
+func TestPBKDF2HasherGenerateSaltThenHash(t *testing.T) {
+	h := NewPBKDF2Hasher(SaltSize, 32, IterationsCount, sha256.New)
+
+	h.GenerateSalt()
+	first := h.Hash([]byte("s3cret"))
+	h.GenerateSalt()
+	second := h.Hash([]byte("s3cret"))
+
+	zeroSalt := NewPBKDF2Hasher(SaltSize, 32, IterationsCount, sha256.New).Hash([]byte("s3cret"))
+	assert.NotEqual(t, zeroSalt, first)
+	assert.NotEqual(t, first, second)
+}
+
+func TestPBKDF2HasherComparePassword(t *testing.T) {
+	h := NewPBKDF2Hasher(SaltSize, 32, IterationsCount, sha256.New)
+
+	salt := h.GenerateSalt()
+	cipherText := base64.StdEncoding.EncodeToString(h.Hasher().Hash([]byte("s3cret"), []byte(salt)))
+
+	assert.NoError(t, h.ComparePassword("s3cret", cipherText, salt))
+	assert.True(t, errors.Is(h.ComparePassword("wrong", cipherText, salt), ErrMismatchedPassword))
+	assert.True(t, errors.Is(h.ComparePassword("s3cret", cipherText, "corrupt"), ErrMalformedSalt))
+	assert.True(t, errors.Is(h.ComparePassword("s3cret", cipherText, "15000.!!!"), ErrMalformedSalt))
+	assert.True(t, errors.Is(h.ComparePassword("s3cret", cipherText, "0."+salt[6:]), ErrIterationsOutOfBounds))
+	assert.True(t, errors.Is(h.ComparePassword("s3cret", "not base64!", salt), ErrMalformedHash))
+
+	assert.True(t, errors.Is(h.ParseSalt("corrupt"), ErrMalformedSalt))
+	assert.False(t, h.VerifyPassword("s3cret", cipherText, "corrupt"))
+
+	// legacy salts below MinIterations still verify but are not converted
+	weakSalt := "100." + salt[6:]
+	assert.NoError(t, h.ParseSalt(weakSalt))
+	weakCipherText := base64.StdEncoding.EncodeToString(h.Hash([]byte("s3cret")))
+	assert.NoError(t, h.ComparePassword("s3cret", weakCipherText, weakSalt))
+	assert.True(t, h.VerifyPassword("s3cret", weakCipherText, weakSalt))
+	_, err := h.ConvertLegacy(weakCipherText, weakSalt)
+	assert.True(t, errors.Is(err, ErrIterationsOutOfBounds))
+}
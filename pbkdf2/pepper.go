@@ -0,0 +1,64 @@
+package pbkdf2
+
+import (
+	"crypto/hmac"
+	"errors"
+	"hash"
+)
+
+// ErrUnknownPepper variable for error of pepper key ID missing from the keyring
+var ErrUnknownPepper = errors.New("unknown pepper key id")
+
+// WithPepper option for mixing a server side secret pepper into passwords with HMAC before PBKDF2,
+// the secret of currentID is used by Encode and its key ID is recorded in the encoded hash as k,
+// the other secrets of keyring remain verifiable so peppers can be rotated.
+// Hashes without a key ID and the deprecated salt based methods are not peppered.
+// When currentID is empty, not a valid key ID or missing from keyring, Encode and Verify return ErrUnknownPepper
+func WithPepper(currentID string, keyring map[string][]byte) Option {
+	return func(h *PBKDF2Hasher) {
+		if _, ok := keyring[currentID]; !ok || !validPepperID(currentID) {
+			h.pepperErr = ErrUnknownPepper
+			return
+		}
+		h.pepperID = currentID
+		h.peppers = make(map[string][]byte, len(keyring))
+		for id, secret := range keyring {
+			h.peppers[id] = append([]byte(nil), secret...)
+		}
+	}
+}
+
+// pepper method for mixing the secret of keyID into password, an empty keyID returns password as is,
+// the error of a misconfigured WithPepper is returned first
+func (h *PBKDF2Hasher) pepper(password []byte, keyID string, fn func() hash.Hash) ([]byte, error) {
+	if h.pepperErr != nil {
+		return nil, h.pepperErr
+	}
+
+	if keyID == "" {
+		return password, nil
+	}
+
+	secret, ok := h.peppers[keyID]
+	if !ok || !validPepperID(keyID) {
+		return nil, ErrUnknownPepper
+	}
+
+	mac := hmac.New(fn, secret)
+	mac.Write(password)
+
+	return mac.Sum(nil), nil
+}
+
+// validPepperID function for checking a key ID can be recorded as a PHC param value
+func validPepperID(keyID string) bool {
+	if keyID == "" {
+		return false
+	}
+	for _, r := range keyID {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package pbkdf2
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithPepper(t *testing.T) {
+	keyring := map[string][]byte{
+		"2021": []byte("old secret"),
+		"2022": []byte("new secret"),
+	}
+	old := NewPBKDF2Hasher(16, 32, MinIterations, sha256.New, WithPepper("2021", keyring))
+	rotated := NewPBKDF2Hasher(16, 32, MinIterations, sha256.New, WithPepper("2022", keyring))
+	plain := NewPBKDF2Hasher(16, 32, MinIterations, sha256.New)
+
+	encoded, err := old.Encode("s3cret")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(encoded, "$pbkdf2-sha256$i=1000,l=32,k=2021$"), encoded)
+
+	t.Run("verify with rotated keyring", func(t *testing.T) {
+		ok, err := rotated.Verify("s3cret", encoded)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		_, err = rotated.Verify("wrong", encoded)
+		assert.Equal(t, ErrMismatchedPassword, err)
+
+		assert.False(t, old.NeedsRehash(encoded))
+		assert.True(t, rotated.NeedsRehash(encoded))
+	})
+
+	t.Run("pepper is required", func(t *testing.T) {
+		_, err := plain.Verify("s3cret", encoded)
+		assert.Equal(t, ErrUnknownPepper, err)
+
+		for _, currentID := range []string{"2023", "", "20 21"} {
+			misconfigured := NewPBKDF2Hasher(16, 32, MinIterations, sha256.New, WithPepper(currentID, keyring))
+
+			_, err = misconfigured.Encode("s3cret")
+			assert.Equal(t, ErrUnknownPepper, err)
+			_, err = misconfigured.Verify("s3cret", encoded)
+			assert.Equal(t, ErrUnknownPepper, err)
+			_, _, err = misconfigured.VerifyAndUpgrade("s3cret", encoded, "")
+			assert.Equal(t, ErrUnknownPepper, err)
+		}
+	})
+
+	t.Run("unpeppered hashes stay verifiable", func(t *testing.T) {
+		unpeppered, err := plain.Encode("s3cret")
+		assert.NoError(t, err)
+
+		ok, err := rotated.Verify("s3cret", unpeppered)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, rotated.NeedsRehash(unpeppered))
+
+		ok, upgraded, err := rotated.VerifyAndUpgrade("s3cret", unpeppered, "")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Contains(t, upgraded, ",k=2022$")
+	})
+}
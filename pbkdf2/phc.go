@@ -0,0 +1,165 @@
+package pbkdf2
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// hashFunction pair of algorithm name used in the PHC identifier and its constructor
+type hashFunction struct {
+	name string
+	fn   func() hash.Hash
+}
+
+// hashFunctions list of hash constructors that can be encoded in a PHC string
+var hashFunctions = []hashFunction{
+	{name: "md5", fn: md5.New},
+	{name: "sha1", fn: sha1.New},
+	{name: "sha224", fn: sha256.New224},
+	{name: "sha256", fn: sha256.New},
+	{name: "sha384", fn: sha512.New384},
+	{name: "sha512", fn: sha512.New},
+	{name: "sha512-224", fn: sha512.New512_224},
+	{name: "sha512-256", fn: sha512.New512_256},
+	{name: "sha3-224", fn: sha3.New224},
+	{name: "sha3-256", fn: sha3.New256},
+	{name: "sha3-384", fn: sha3.New384},
+	{name: "sha3-512", fn: sha3.New512},
+	{name: "keccak256", fn: sha3.NewLegacyKeccak256},
+	{name: "keccak512", fn: sha3.NewLegacyKeccak512},
+}
+
+// hashFingerprints digest of an empty input for every supported hash function,
+// used for recognizing a constructor without relying on its concrete type
+var hashFingerprints = func() map[string]string {
+	fingerprints := make(map[string]string, len(hashFunctions))
+	for _, f := range hashFunctions {
+		fingerprints[hashFingerprint(f.fn)] = f.name
+	}
+	return fingerprints
+}()
+
+func hashFingerprint(fn func() hash.Hash) string {
+	return hex.EncodeToString(fn().Sum(nil))
+}
+
+// hashName function for getting the PHC algorithm name of a hash constructor
+func hashName(fn func() hash.Hash) (string, bool) {
+	if fn == nil {
+		return "", false
+	}
+	name, ok := hashFingerprints[hashFingerprint(fn)]
+	return name, ok
+}
+
+// hashByName function for getting a hash constructor from its PHC algorithm name
+func hashByName(name string) (func() hash.Hash, bool) {
+	for _, f := range hashFunctions {
+		if f.name == name {
+			return f.fn, true
+		}
+	}
+	return nil, false
+}
+
+// phc data structure of a PHC string format value
+// $<id>[$v=<version>][$<param>=<value>(,<param>=<value>)*][$<salt>[$<hash>]]
+type phc struct {
+	id      string
+	version string
+	params  map[string]string
+	salt    []byte
+	hash    []byte
+}
+
+// parsePHC function for parsing a PHC string format value
+func parsePHC(encoded string) (*phc, error) {
+	if !strings.HasPrefix(encoded, "$") {
+		return nil, ErrMalformedHash
+	}
+
+	fields := strings.Split(encoded[1:], "$")
+	if fields[0] == "" {
+		return nil, ErrMalformedHash
+	}
+
+	p := &phc{id: fields[0], params: make(map[string]string)}
+	fields = fields[1:]
+
+	if len(fields) > 0 && strings.HasPrefix(fields[0], "v=") {
+		p.version = strings.TrimPrefix(fields[0], "v=")
+		fields = fields[1:]
+	}
+
+	if len(fields) > 0 && strings.Contains(fields[0], "=") {
+		for _, param := range strings.Split(fields[0], ",") {
+			kv := strings.SplitN(param, "=", 2)
+			if len(kv) != 2 || kv[0] == "" {
+				return nil, ErrMalformedHash
+			}
+			p.params[kv[0]] = kv[1]
+		}
+		fields = fields[1:]
+	}
+
+	if len(fields) > 2 {
+		return nil, ErrMalformedHash
+	}
+
+	var err error
+	if len(fields) > 0 {
+		if p.salt, err = base64.RawStdEncoding.DecodeString(fields[0]); err != nil {
+			return nil, ErrMalformedHash
+		}
+	}
+	if len(fields) > 1 {
+		if p.hash, err = base64.RawStdEncoding.DecodeString(fields[1]); err != nil {
+			return nil, ErrMalformedHash
+		}
+	}
+
+	return p, nil
+}
+
+// format method for formatting PHC data into string format, params are
+// written in the given order
+func (p *phc) format(order ...string) string {
+	var b strings.Builder
+	b.WriteString("$")
+	b.WriteString(p.id)
+
+	if p.version != "" {
+		b.WriteString("$v=")
+		b.WriteString(p.version)
+	}
+
+	var params []string
+	for _, key := range order {
+		if value, ok := p.params[key]; ok {
+			params = append(params, key+"="+value)
+		}
+	}
+	if len(params) > 0 {
+		b.WriteString("$")
+		b.WriteString(strings.Join(params, ","))
+	}
+
+	if p.salt != nil {
+		b.WriteString("$")
+		b.WriteString(base64.RawStdEncoding.EncodeToString(p.salt))
+		if p.hash != nil {
+			b.WriteString("$")
+			b.WriteString(base64.RawStdEncoding.EncodeToString(p.hash))
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,147 @@
+package pbkdf2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+// ErrPoolTimeout variable for error of context done before a hashing slot is available
+var ErrPoolTimeout = errors.New("no hashing slot available before context done")
+
+// Pool data structure for limiting the number of concurrent key derivations of an Algorithm,
+// callers above the limit wait in queue until a slot is free or their context is done
+type Pool struct {
+	// counters are accessed atomically and kept first for 64-bit alignment
+	waiting   int64
+	active    int64
+	completed uint64
+	rejected  uint64
+	totalWait int64
+	maxWait   int64
+
+	alg   Algorithm
+	slots chan struct{}
+}
+
+// PoolStats data structure of a Pool snapshot, QueueDepth is the number of callers waiting for a slot
+type PoolStats struct {
+	Capacity    int
+	Active      int
+	QueueDepth  int
+	Completed   uint64
+	Rejected    uint64
+	TotalWait   time.Duration
+	MaxWait     time.Duration
+	AverageWait time.Duration
+}
+
+// NewPool function for creating hashing pool around alg with size concurrent slots,
+// a size lower than 1 uses the number of CPUs
+func NewPool(alg Algorithm, size int) *Pool {
+	if size < 1 {
+		size = runtime.NumCPU()
+	}
+	return &Pool{
+		alg:   alg,
+		slots: make(chan struct{}, size),
+	}
+}
+
+// Encode method for hashing password with the pool algorithm once a slot is acquired
+func (p *Pool) Encode(ctx context.Context, password string) (string, error) {
+	var encoded string
+	err := p.Do(ctx, func() error {
+		var err error
+		encoded, err = p.alg.Encode(password)
+		return err
+	})
+	return encoded, err
+}
+
+// Verify method for checking password with the pool algorithm once a slot is acquired
+func (p *Pool) Verify(ctx context.Context, password, encoded string) (bool, error) {
+	var ok bool
+	err := p.Do(ctx, func() error {
+		var err error
+		ok, err = p.alg.Verify(password, encoded)
+		return err
+	})
+	return ok, err
+}
+
+// NeedsRehash method for checking an encoded hash against the pool algorithm policy, no slot is used
+func (p *Pool) NeedsRehash(encoded string) bool {
+	return p.alg.NeedsRehash(encoded)
+}
+
+// Do method for running fn once a slot is acquired, e.g. for the legacy ComparePassword,
+// ErrPoolTimeout is returned without running fn when ctx is done first
+func (p *Pool) Do(ctx context.Context, fn func() error) error {
+	if err := p.acquire(ctx); err != nil {
+		return err
+	}
+	defer p.release()
+
+	return fn()
+}
+
+// Stats method for getting the pool capacity, queue depth and wait time statistics
+func (p *Pool) Stats() PoolStats {
+	stats := PoolStats{
+		Capacity:   cap(p.slots),
+		Active:     int(atomic.LoadInt64(&p.active)),
+		QueueDepth: int(atomic.LoadInt64(&p.waiting)),
+		Completed:  atomic.LoadUint64(&p.completed),
+		Rejected:   atomic.LoadUint64(&p.rejected),
+		TotalWait:  time.Duration(atomic.LoadInt64(&p.totalWait)),
+		MaxWait:    time.Duration(atomic.LoadInt64(&p.maxWait)),
+	}
+	if acquired := stats.Completed + uint64(stats.Active); acquired > 0 {
+		stats.AverageWait = stats.TotalWait / time.Duration(acquired)
+	}
+	return stats
+}
+
+func (p *Pool) acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		atomic.AddUint64(&p.rejected, 1)
+		return fmt.Errorf("%w: %v", ErrPoolTimeout, err)
+	}
+
+	start := time.Now()
+	select {
+	case p.slots <- struct{}{}:
+	default:
+		atomic.AddInt64(&p.waiting, 1)
+		select {
+		case p.slots <- struct{}{}:
+			atomic.AddInt64(&p.waiting, -1)
+		case <-ctx.Done():
+			atomic.AddInt64(&p.waiting, -1)
+			atomic.AddUint64(&p.rejected, 1)
+			return fmt.Errorf("%w: %v", ErrPoolTimeout, ctx.Err())
+		}
+	}
+
+	wait := int64(time.Since(start))
+	atomic.AddInt64(&p.totalWait, wait)
+	for {
+		current := atomic.LoadInt64(&p.maxWait)
+		if wait <= current || atomic.CompareAndSwapInt64(&p.maxWait, current, wait) {
+			break
+		}
+	}
+	atomic.AddInt64(&p.active, 1)
+
+	return nil
+}
+
+func (p *Pool) release() {
+	atomic.AddInt64(&p.active, -1)
+	atomic.AddUint64(&p.completed, 1)
+	<-p.slots
+}
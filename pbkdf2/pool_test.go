@@ -0,0 +1,83 @@
+package pbkdf2
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPool(t *testing.T) {
+	pool := NewPool(NewPBKDF2Hasher(16, 32, MinIterations, sha256.New), 2)
+
+	encoded, err := pool.Encode(context.Background(), "s3cret")
+	assert.NoError(t, err)
+	assert.False(t, pool.NeedsRehash(encoded))
+
+	ok, err := pool.Verify(context.Background(), "s3cret", encoded)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = pool.Verify(context.Background(), "wrong", encoded)
+	assert.Equal(t, ErrMismatchedPassword, err)
+
+	stats := pool.Stats()
+	assert.Equal(t, 2, stats.Capacity)
+	assert.Equal(t, uint64(3), stats.Completed)
+	assert.Equal(t, 0, stats.Active)
+	assert.Equal(t, 0, stats.QueueDepth)
+}
+
+func TestPoolTimeout(t *testing.T) {
+	pool := NewPool(NewPBKDF2Hasher(16, 32, MinIterations, sha256.New), 1)
+
+	hold, held := make(chan struct{}), make(chan struct{})
+	go pool.Do(context.Background(), func() error {
+		close(held)
+		<-hold
+		return nil
+	})
+	<-held
+
+	t.Run("wait until deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := pool.Encode(ctx, "s3cret")
+		assert.True(t, errors.Is(err, ErrPoolTimeout))
+	})
+
+	t.Run("fail fast on done context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := pool.Verify(ctx, "s3cret", "$pbkdf2-sha256$")
+		assert.True(t, errors.Is(err, ErrPoolTimeout))
+	})
+
+	t.Run("queue until slot is released", func(t *testing.T) {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := pool.Encode(context.Background(), "s3cret")
+			assert.NoError(t, err)
+		}()
+
+		for pool.Stats().QueueDepth == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		close(hold)
+		wg.Wait()
+	})
+
+	stats := pool.Stats()
+	assert.Equal(t, uint64(2), stats.Rejected)
+	assert.Equal(t, uint64(2), stats.Completed)
+	assert.Greater(t, int64(stats.MaxWait), int64(0))
+	assert.Equal(t, 0, stats.QueueDepth)
+}
@@ -0,0 +1,146 @@
+package pbkdf2
+
+import (
+	"strings"
+)
+
+// Algorithm interface abstraction for password hashers producing self-describing encoded hashes
+type Algorithm interface {
+	ID() string
+	Encode(password string) (string, error)
+	Verify(password, encoded string) (bool, error)
+	NeedsRehash(encoded string) bool
+}
+
+// Registry data structure for hashing new passwords with the current algorithm while
+// verifying stored hashes with the algorithm identified by their prefix
+type Registry struct {
+	current    Algorithm
+	legacy     *PBKDF2Hasher
+	algorithms map[string]Algorithm
+}
+
+// NewRegistry function for creating password hashing registry,
+// legacy is used for the salt based PasswordHasher methods and for verifying pbkdf2 hashes,
+// current is used for hashing new passwords and others are only used for verifying
+func NewRegistry(legacy *PBKDF2Hasher, current Algorithm, others ...Algorithm) *Registry {
+	r := &Registry{
+		current:    current,
+		legacy:     legacy,
+		algorithms: make(map[string]Algorithm),
+	}
+
+	if legacy != nil {
+		r.algorithms[legacy.ID()] = legacy
+	}
+	for _, alg := range others {
+		r.algorithms[alg.ID()] = alg
+	}
+	if current != nil {
+		r.algorithms[current.ID()] = current
+	}
+
+	return r
+}
+
+// ID method for getting the identifier of the current algorithm
+func (r *Registry) ID() string {
+	if r.current == nil {
+		return ""
+	}
+	return r.current.ID()
+}
+
+// Encode method for hashing password with the current algorithm
+func (r *Registry) Encode(password string) (string, error) {
+	if r.current == nil {
+		return "", ErrUnsupportedAlgorithm
+	}
+	return r.current.Encode(password)
+}
+
+// Verify method for checking password against an encoded hash of any registered algorithm
+func (r *Registry) Verify(password, encoded string) (bool, error) {
+	alg, ok := r.algorithms[algorithmID(encoded)]
+	if !ok {
+		return false, ErrUnsupportedAlgorithm
+	}
+	return alg.Verify(password, encoded)
+}
+
+// NeedsRehash method for checking whether an encoded hash was not created by the
+// current algorithm or is below its policy
+func (r *Registry) NeedsRehash(encoded string) bool {
+	if r.current == nil {
+		return false
+	}
+	if algorithmID(encoded) != r.current.ID() {
+		return true
+	}
+	return r.current.NeedsRehash(encoded)
+}
+
+// VerifyAndUpgrade method for checking password like VerifyPassword and, when the stored
+// value needs rehash, returning a fresh hash of the current algorithm to be persisted
+func (r *Registry) VerifyAndUpgrade(password, cipherText, salt string) (bool, string, error) {
+	return verifyAndUpgrade(r, password, cipherText, salt, r.ComparePassword)
+}
+
+// Hash method for hashing data with the legacy PBKDF2Hasher
+//
+// Deprecated: use Encode.
+func (r *Registry) Hash(data []byte) []byte {
+	return r.legacy.Hash(data)
+}
+
+// ParseSalt method for parsing salt with the legacy PBKDF2Hasher
+//
+// Deprecated: use Encode.
+func (r *Registry) ParseSalt(salt string) error {
+	return r.legacy.ParseSalt(salt)
+}
+
+// GenerateSalt method for generating salt with the legacy PBKDF2Hasher
+//
+// Deprecated: use Encode.
+func (r *Registry) GenerateSalt() string {
+	return r.legacy.GenerateSalt()
+}
+
+// VerifyPassword method for checking password against a stored cipher text,
+// an encoded hash is verified by its algorithm and the salt is ignored,
+// otherwise the legacy PBKDF2Hasher scheme is used
+func (r *Registry) VerifyPassword(password, cipherText, salt string) bool {
+	return r.ComparePassword(password, cipherText, salt) == nil
+}
+
+// ComparePassword method for checking password like VerifyPassword and returning ErrMismatchedPassword
+// for a wrong password or another error when the stored cipher text or salt is corrupt
+func (r *Registry) ComparePassword(password, cipherText, salt string) error {
+	if strings.HasPrefix(cipherText, "$") {
+		_, err := r.Verify(password, cipherText)
+		return err
+	}
+
+	if r.legacy == nil {
+		return ErrUnsupportedAlgorithm
+	}
+	return r.legacy.ComparePassword(password, cipherText, salt)
+}
+
+// algorithmID function for getting the registry identifier from an encoded hash prefix
+func algorithmID(encoded string) string {
+	if !strings.HasPrefix(encoded, "$") {
+		return ""
+	}
+
+	id := strings.SplitN(encoded[1:], "$", 2)[0]
+	switch {
+	case strings.HasPrefix(id, "pbkdf2-"):
+		return "pbkdf2"
+	case id == "2a" || id == "2b" || id == "2y":
+		return "bcrypt"
+	}
+
+	return id
+}
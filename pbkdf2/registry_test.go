@@ -0,0 +1,221 @@
+package pbkdf2
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestArgon2id function for creating a cheap argon2id hasher of time passes
+func newTestArgon2id(time uint32) *Argon2idHasher {
+	h, err := NewArgon2idHasher(16, 32, time, 1024, 1)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// newTestScrypt function for creating a cheap scrypt hasher
+func newTestScrypt() *ScryptHasher {
+	h, err := NewScryptHasher(16, 32, 1<<10, 8, 1)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+func newTestRegistry() *Registry {
+	return NewRegistry(
+		NewPBKDF2Hasher(SaltSize, 32, 1000, sha256.New),
+		newTestArgon2id(1),
+		NewBcryptHasher(4),
+		newTestScrypt(),
+	)
+}
+
+func TestAlgorithms(t *testing.T) {
+	tests := []struct {
+		name   string
+		alg    Algorithm
+		prefix string
+	}{
+		{name: "pbkdf2", alg: NewPBKDF2Hasher(16, 32, 1000, sha512.New), prefix: "$pbkdf2-sha512$"},
+		{name: "argon2id", alg: newTestArgon2id(1), prefix: "$argon2id$v=19$m=1024,t=1,p=1$"},
+		{name: "bcrypt", alg: NewBcryptHasher(4), prefix: "$2a$04$"},
+		{name: "scrypt", alg: newTestScrypt(), prefix: "$scrypt$ln=10,r=8,p=1$"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := tt.alg.Encode("s3cret")
+			assert.NoError(t, err)
+			assert.True(t, strings.HasPrefix(encoded, tt.prefix), encoded)
+
+			ok, err := tt.alg.Verify("s3cret", encoded)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+
+			ok, err = tt.alg.Verify("wrong", encoded)
+			assert.Equal(t, ErrMismatchedPassword, err)
+			assert.False(t, ok)
+
+			// every registered algorithm is picked from the encoded prefix
+			ok, err = newTestRegistry().Verify("s3cret", encoded)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	r := newTestRegistry()
+
+	t.Run("encode with current algorithm", func(t *testing.T) {
+		encoded, err := r.Encode("s3cret")
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(encoded, "$argon2id$"))
+		assert.True(t, r.VerifyPassword("s3cret", encoded, ""))
+		assert.False(t, r.VerifyPassword("wrong", encoded, ""))
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := r.Verify("s3cret", "$md5$c2FsdA$aGFzaA")
+		assert.Equal(t, ErrUnsupportedAlgorithm, err)
+
+		_, err = r.Verify("s3cret", "plain")
+		assert.Equal(t, ErrUnsupportedAlgorithm, err)
+	})
+
+	t.Run("legacy pbkdf2", func(t *testing.T) {
+		legacy := NewPBKDF2Hasher(SaltSize, 32, 1000, sha256.New)
+		salt := legacy.GenerateSalt()
+		assert.NoError(t, legacy.ParseSalt(salt))
+		cipherText := base64.StdEncoding.EncodeToString(legacy.Hash([]byte("s3cret")))
+
+		assert.True(t, r.VerifyPassword("s3cret", cipherText, salt))
+		assert.False(t, r.VerifyPassword("wrong", cipherText, salt))
+
+		encoded, err := legacy.ConvertLegacy(cipherText, salt)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(encoded, "$pbkdf2-sha256$i=1000,l=32$"))
+
+		ok, err := r.Verify("s3cret", encoded)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
+
+func TestNeedsRehash(t *testing.T) {
+	weak := NewPBKDF2Hasher(16, 32, 1000, sha256.New)
+	policy := NewPBKDF2Hasher(16, 32, 2000, sha256.New)
+
+	encoded, err := weak.Encode("s3cret")
+	assert.NoError(t, err)
+
+	assert.False(t, weak.NeedsRehash(encoded))
+	assert.True(t, policy.NeedsRehash(encoded))
+	assert.True(t, NewPBKDF2Hasher(32, 32, 1000, sha256.New).NeedsRehash(encoded))
+	assert.True(t, NewPBKDF2Hasher(16, 64, 1000, sha256.New).NeedsRehash(encoded))
+	assert.True(t, NewPBKDF2Hasher(16, 32, 1000, sha512.New).NeedsRehash(encoded))
+	assert.True(t, policy.NeedsRehash("legacy"))
+
+	assert.True(t, newTestArgon2id(2).NeedsRehash("$argon2id$v=19$m=1024,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$aGFzaGhhc2hoYXNoaGFzaGhhc2hoYXNoaGFzaGhhc2g"))
+	assert.False(t, newTestArgon2id(1).NeedsRehash("$argon2id$v=19$m=1024,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$aGFzaGhhc2hoYXNoaGFzaGhhc2hoYXNoaGFzaGhhc2g"))
+
+	bcryptEncoded, err := NewBcryptHasher(4).Encode("s3cret")
+	assert.NoError(t, err)
+	assert.True(t, NewBcryptHasher(5).NeedsRehash(bcryptEncoded))
+	assert.False(t, NewBcryptHasher(4).NeedsRehash(bcryptEncoded))
+
+	r := newTestRegistry()
+	assert.True(t, r.NeedsRehash(encoded))
+	assert.True(t, r.NeedsRehash(bcryptEncoded))
+}
+
+func TestVerifyAndUpgrade(t *testing.T) {
+	weak := NewPBKDF2Hasher(16, 32, 1000, sha256.New)
+	policy := NewPBKDF2Hasher(16, 32, 2000, sha256.New)
+
+	t.Run("upgrade below policy", func(t *testing.T) {
+		encoded, _ := weak.Encode("s3cret")
+
+		ok, upgraded, err := policy.VerifyAndUpgrade("s3cret", encoded, "")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, strings.HasPrefix(upgraded, "$pbkdf2-sha256$i=2000,l=32$"))
+		assert.False(t, policy.NeedsRehash(upgraded))
+
+		ok, upgraded, err = policy.VerifyAndUpgrade("wrong", encoded, "")
+		assert.Equal(t, ErrMismatchedPassword, err)
+		assert.False(t, ok)
+		assert.Empty(t, upgraded)
+	})
+
+	t.Run("keep hash within policy", func(t *testing.T) {
+		encoded, _ := policy.Encode("s3cret")
+
+		ok, upgraded, err := policy.VerifyAndUpgrade("s3cret", encoded, "")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Empty(t, upgraded)
+	})
+
+	t.Run("upgrade legacy to registry algorithm", func(t *testing.T) {
+		r := newTestRegistry()
+		legacy := NewPBKDF2Hasher(SaltSize, 32, 1000, sha256.New)
+		salt := legacy.GenerateSalt()
+		assert.NoError(t, legacy.ParseSalt(salt))
+		cipherText := base64.StdEncoding.EncodeToString(legacy.Hash([]byte("s3cret")))
+
+		ok, upgraded, err := r.VerifyAndUpgrade("s3cret", cipherText, salt)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, strings.HasPrefix(upgraded, "$argon2id$"))
+		assert.True(t, r.VerifyPassword("s3cret", upgraded, ""))
+	})
+}
+
+func TestCostBounds(t *testing.T) {
+	t.Run("invalid constructor input", func(t *testing.T) {
+		_, err := NewScryptHasher(16, 32, 0, 8, 1)
+		assert.Equal(t, ErrIterationsOutOfBounds, err)
+		_, err = NewScryptHasher(16, 32, 1<<10, 0, 1)
+		assert.Equal(t, ErrIterationsOutOfBounds, err)
+		_, err = NewScryptHasher(16, 32, 1<<30, 8, 1)
+		assert.Equal(t, ErrIterationsOutOfBounds, err)
+		_, err = NewScryptHasher(0, 32, 1<<10, 8, 1)
+		assert.Equal(t, ErrInvalidSize, err)
+
+		_, err = NewArgon2idHasher(16, 32, 1, 1024, 0)
+		assert.Equal(t, ErrIterationsOutOfBounds, err)
+		_, err = NewArgon2idHasher(16, 32, 0, 1024, 1)
+		assert.Equal(t, ErrIterationsOutOfBounds, err)
+		_, err = NewArgon2idHasher(16, 32, 1, Argon2MaxMemory+1, 1)
+		assert.Equal(t, ErrIterationsOutOfBounds, err)
+		_, err = NewArgon2idHasher(16, 0, 1, 1024, 1)
+		assert.Equal(t, ErrInvalidSize, err)
+	})
+
+	t.Run("hostile stored cost", func(t *testing.T) {
+		argon2Encoded, err := newTestArgon2id(1).Encode("s3cret")
+		assert.NoError(t, err)
+		scryptEncoded, err := newTestScrypt().Encode("s3cret")
+		assert.NoError(t, err)
+
+		for _, encoded := range []string{
+			strings.Replace(argon2Encoded, "m=1024", "m=4194304", 1),
+			strings.Replace(argon2Encoded, "t=1", "t=100000", 1),
+			strings.Replace(argon2Encoded, "p=1", "p=255", 1),
+			strings.Replace(scryptEncoded, "ln=10", "ln=30", 1),
+			strings.Replace(scryptEncoded, "r=8", "r=100000", 1),
+			strings.Replace(scryptEncoded, "p=1", "p=1000", 1),
+		} {
+			ok, err := newTestRegistry().Verify("s3cret", encoded)
+			assert.Equal(t, ErrIterationsOutOfBounds, err, encoded)
+			assert.False(t, ok)
+		}
+	})
+}
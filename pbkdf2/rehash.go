@@ -0,0 +1,22 @@
+package pbkdf2
+
+import "strings"
+
+// verifyAndUpgrade function for checking password with compare and encoding a fresh hash
+// with alg when the stored cipher text is a legacy value or needs rehash
+func verifyAndUpgrade(alg Algorithm, password, cipherText, salt string, compare func(password, cipherText, salt string) error) (bool, string, error) {
+	if err := compare(password, cipherText, salt); err != nil {
+		return false, "", err
+	}
+
+	if strings.HasPrefix(cipherText, "$") && !alg.NeedsRehash(cipherText) {
+		return true, "", nil
+	}
+
+	upgraded, err := alg.Encode(password)
+	if err != nil {
+		return true, "", err
+	}
+
+	return true, upgraded, nil
+}
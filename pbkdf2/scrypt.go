@@ -0,0 +1,171 @@
+package pbkdf2
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"math/bits"
+	"strconv"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// ScryptCost to set the scrypt CPU/memory cost parameter N, must be a power of two
+	ScryptCost = 1 << 17
+	// ScryptBlockSize to set the scrypt block size parameter r
+	ScryptBlockSize = 8
+	// ScryptParallelism to set the scrypt parallelization parameter p
+	ScryptParallelism = 1
+
+	// ScryptMaxMemory to set the highest scrypt memory 128*N*r in bytes accepted from a stored hash
+	ScryptMaxMemory = 1 << 30
+	// ScryptMaxParallelism to set the highest scrypt parallelization accepted from a stored hash
+	ScryptMaxParallelism = 16
+)
+
+// ScryptHasher data structure
+type ScryptHasher struct {
+	saltSize int
+	keyLen   int
+	logCost  int
+	r        int
+	p        int
+}
+
+// NewScryptHasher function for creating scrypt hashing service, cost is rounded down to a power of two.
+// ErrInvalidSize is returned for a non positive salt size or key length and ErrIterationsOutOfBounds
+// for a cost below 2 or a cost Verify would reject
+func NewScryptHasher(saltSize, keyLen, cost, r, p int) (*ScryptHasher, error) {
+	if saltSize < 1 || keyLen < 1 {
+		return nil, ErrInvalidSize
+	}
+	if cost < 2 {
+		return nil, ErrIterationsOutOfBounds
+	}
+	logCost := bits.Len(uint(cost)) - 1
+	if !validScryptCost(logCost, r, p) {
+		return nil, ErrIterationsOutOfBounds
+	}
+
+	return &ScryptHasher{
+		saltSize: saltSize,
+		keyLen:   keyLen,
+		logCost:  logCost,
+		r:        r,
+		p:        p,
+	}, nil
+}
+
+// ID method for getting the algorithm identifier
+func (h *ScryptHasher) ID() string {
+	return "scrypt"
+}
+
+// Encode method for hashing password into a PHC string format value,
+// e.g. $scrypt$ln=17,r=8,p=1$<salt>$<hash>
+func (h *ScryptHasher) Encode(password string) (string, error) {
+	salt := make([]byte, h.saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key, err := scrypt.Key([]byte(password), salt, 1<<h.logCost, h.r, h.p, h.keyLen)
+	if err != nil {
+		return "", err
+	}
+
+	p := &phc{
+		id: h.ID(),
+		params: map[string]string{
+			"ln": strconv.Itoa(h.logCost),
+			"r":  strconv.Itoa(h.r),
+			"p":  strconv.Itoa(h.p),
+		},
+		salt: salt,
+		hash: key,
+	}
+
+	return p.format("ln", "r", "p"), nil
+}
+
+// Verify method for checking password against a scrypt PHC string
+func (h *ScryptHasher) Verify(password, encoded string) (bool, error) {
+	p, err := parseScrypt(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	df, err := scrypt.Key([]byte(password), p.salt, 1<<p.logCost, p.r, p.p, len(p.hash))
+	if err != nil {
+		return false, ErrIterationsOutOfBounds
+	}
+	if subtle.ConstantTimeCompare(df, p.hash) != 1 {
+		return false, ErrMismatchedPassword
+	}
+
+	return true, nil
+}
+
+// NeedsRehash method for checking whether an encoded hash is below the hasher policy
+func (h *ScryptHasher) NeedsRehash(encoded string) bool {
+	p, err := parseScrypt(encoded)
+	if err != nil {
+		return true
+	}
+
+	return p.logCost < h.logCost ||
+		p.r < h.r ||
+		p.p < h.p ||
+		len(p.salt) < h.saltSize ||
+		len(p.hash) != h.keyLen
+}
+
+// scryptParams data structure of a decoded scrypt PHC string
+type scryptParams struct {
+	*phc
+	logCost int
+	r       int
+	p       int
+}
+
+// parseScrypt function for parsing and validating a scrypt PHC string
+func parseScrypt(encoded string) (*scryptParams, error) {
+	p, err := parsePHC(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.id != "scrypt" {
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	params := &scryptParams{phc: p}
+
+	if params.logCost, err = strconv.Atoi(p.params["ln"]); err != nil {
+		return nil, ErrMalformedHash
+	}
+	if params.r, err = strconv.Atoi(p.params["r"]); err != nil || params.r < 1 {
+		return nil, ErrMalformedHash
+	}
+	if params.p, err = strconv.Atoi(p.params["p"]); err != nil || params.p < 1 {
+		return nil, ErrMalformedHash
+	}
+	if !validScryptCost(params.logCost, params.r, params.p) {
+		return nil, ErrIterationsOutOfBounds
+	}
+
+	if len(p.salt) == 0 || len(p.hash) == 0 {
+		return nil, ErrMalformedHash
+	}
+
+	return params, nil
+}
+
+// validScryptCost function for checking that scrypt cost 2^logCost, r and p stay within
+// ScryptMaxMemory and ScryptMaxParallelism
+func validScryptCost(logCost, r, p int) bool {
+	if logCost < 1 || logCost > 30 || r < 1 || p < 1 || p > ScryptMaxParallelism {
+		return false
+	}
+	return r <= ScryptMaxMemory>>7>>uint(logCost)
+}
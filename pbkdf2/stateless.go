@@ -0,0 +1,50 @@
+package pbkdf2
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"hash"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Hasher data structure of a stateless pbkdf2 hasher, the salt is passed on every call
+// and nothing is written after construction so it is safe for concurrent use
+type Hasher struct {
+	saltSize  int
+	keyLen    int
+	iteration int
+	hash      func() hash.Hash
+	algorithm string
+}
+
+// NewHasher function for creating stateless hashing service
+func NewHasher(saltSize, keyLen, iteration int, hash func() hash.Hash) *Hasher {
+	algorithm, _ := hashName(hash)
+	return &Hasher{
+		saltSize:  saltSize,
+		keyLen:    keyLen,
+		iteration: iteration,
+		hash:      hash,
+		algorithm: algorithm,
+	}
+}
+
+// GenerateSalt method for generating a new random salt of the configured size
+func (h *Hasher) GenerateSalt() ([]byte, error) {
+	salt := make([]byte, h.saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
+
+// Hash method for deriving the key of password with salt
+func (h *Hasher) Hash(password, salt []byte) []byte {
+	return pbkdf2.Key(password, salt, h.iteration, h.keyLen, h.hash)
+}
+
+// Verify method for checking password with salt against a key created by Hash
+func (h *Hasher) Verify(password, salt, cipherText []byte) bool {
+	return subtle.ConstantTimeCompare(h.Hash(password, salt), cipherText) == 1
+}
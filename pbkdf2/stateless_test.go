@@ -0,0 +1,77 @@
+package pbkdf2
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasher(t *testing.T) {
+	h := NewHasher(16, 32, 1000, sha256.New)
+
+	salt, err := h.GenerateSalt()
+	assert.NoError(t, err)
+	assert.Len(t, salt, 16)
+
+	cipherText := h.Hash([]byte("s3cret"), salt)
+	assert.Len(t, cipherText, 32)
+	assert.Equal(t, cipherText, h.Hash([]byte("s3cret"), salt))
+
+	assert.True(t, h.Verify([]byte("s3cret"), salt, cipherText))
+	assert.False(t, h.Verify([]byte("wrong"), salt, cipherText))
+	assert.False(t, h.Verify([]byte("s3cret"), []byte("other salt"), cipherText))
+}
+
+func TestHasherParallel(t *testing.T) {
+	h := NewHasher(16, 32, 100, sha256.New)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			password := []byte(fmt.Sprintf("password-%d", i))
+			salt, err := h.GenerateSalt()
+			assert.NoError(t, err)
+
+			cipherText := h.Hash(password, salt)
+			assert.True(t, h.Verify(password, salt, cipherText))
+			assert.False(t, h.Verify([]byte("wrong"), salt, cipherText))
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestPBKDF2HasherParallel(t *testing.T) {
+	h := NewPBKDF2Hasher(16, 32, MinIterations, sha256.New)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+
+			password := fmt.Sprintf("password-%d", i)
+			encoded, err := h.Encode(password)
+			assert.NoError(t, err)
+
+			ok, err := h.Verify(password, encoded)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+
+			// the deprecated methods must not race with Encode and Verify
+			password := fmt.Sprintf("legacy-%d", i)
+			salt := h.GenerateSalt()
+			assert.NoError(t, h.ParseSalt(salt))
+			h.Hash([]byte(password))
+		}(i)
+	}
+	wg.Wait()
+}